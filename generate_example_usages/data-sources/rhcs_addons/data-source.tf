@@ -0,0 +1,3 @@
+data "rhcs_addons" "addons" {
+  search = "id = 'cluster-logging-operator'"
+}
@@ -0,0 +1,3 @@
+data "rhcs_cluster_available_upgrades" "upgrades" {
+  cluster = "cluster-id-123"
+}
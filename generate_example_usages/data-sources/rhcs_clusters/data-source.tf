@@ -0,0 +1,4 @@
+data "rhcs_clusters" "ready_clusters" {
+  search = "region.id = 'us-east-1' and state = 'ready'"
+  order  = "name asc"
+}
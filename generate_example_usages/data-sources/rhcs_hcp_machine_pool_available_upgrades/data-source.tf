@@ -0,0 +1,4 @@
+data "rhcs_hcp_machine_pool_available_upgrades" "upgrades" {
+  cluster      = "cluster-id-123"
+  machine_pool = "workers"
+}
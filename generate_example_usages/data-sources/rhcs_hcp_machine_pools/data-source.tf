@@ -0,0 +1,4 @@
+data "rhcs_hcp_machine_pools" "outdated_machine_pools" {
+  cluster = "cluster-id-123"
+  version = "< 4.15.0"
+}
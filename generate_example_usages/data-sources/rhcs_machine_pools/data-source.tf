@@ -0,0 +1,6 @@
+data "rhcs_machine_pools" "machine_pools" {
+  cluster = "cluster-id-123"
+  labels = {
+    "role" = "infra"
+  }
+}
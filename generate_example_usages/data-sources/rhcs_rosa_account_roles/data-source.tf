@@ -0,0 +1,28 @@
+data "rhcs_rosa_account_roles" "account_roles" {
+  account_role_prefix = "<account-role-prefix>"
+  account_id          = "<aws-account-id>"
+  openshift_version   = "<openshift-version>"
+}
+
+resource "aws_iam_role" "account_roles" {
+  for_each             = { for role in data.rhcs_rosa_account_roles.account_roles.account_roles : role.role_type => role }
+  name                 = each.value.role_name
+  path                 = data.rhcs_rosa_account_roles.account_roles.path
+  assume_role_policy   = each.value.assume_role_policy
+  permissions_boundary = each.value.permissions_boundary
+  tags                 = each.value.tags
+}
+
+resource "aws_iam_policy" "account_role_policies" {
+  for_each = { for role in data.rhcs_rosa_account_roles.account_roles.account_roles : role.role_type => role }
+  name     = each.value.policy_name
+  path     = data.rhcs_rosa_account_roles.account_roles.path
+  policy   = each.value.policy_document
+  tags     = each.value.tags
+}
+
+resource "aws_iam_role_policy_attachment" "account_role_policy_attachments" {
+  for_each   = aws_iam_role.account_roles
+  role       = each.value.name
+  policy_arn = aws_iam_policy.account_role_policies[each.key].arn
+}
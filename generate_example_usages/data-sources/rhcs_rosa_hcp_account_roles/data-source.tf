@@ -0,0 +1,19 @@
+data "rhcs_rosa_hcp_account_roles" "account_roles" {
+  account_role_prefix = "<account-role-prefix>"
+  account_id          = "<aws-account-id>"
+}
+
+resource "aws_iam_role" "account_roles" {
+  for_each             = { for role in data.rhcs_rosa_hcp_account_roles.account_roles.account_roles : role.role_type => role }
+  name                 = each.value.role_name
+  path                 = data.rhcs_rosa_hcp_account_roles.account_roles.path
+  assume_role_policy   = each.value.assume_role_policy
+  permissions_boundary = each.value.permissions_boundary
+  tags                 = each.value.tags
+}
+
+resource "aws_iam_role_policy_attachment" "account_role_policy_attachments" {
+  for_each   = { for role in data.rhcs_rosa_hcp_account_roles.account_roles.account_roles : role.role_type => role }
+  role       = aws_iam_role.account_roles[each.key].name
+  policy_arn = each.value.policy_arn
+}
@@ -0,0 +1,9 @@
+data "rhcs_rosa_oidc_configs" "managed" {
+  managed = true
+}
+
+resource "aws_iam_openid_connect_provider" "oidc_provider" {
+  url             = "https://${data.rhcs_rosa_oidc_configs.managed.items[0].oidc_endpoint_url}"
+  client_id_list  = ["openshift", "sts.amazonaws.com"]
+  thumbprint_list = [data.rhcs_rosa_oidc_configs.managed.items[0].thumbprint]
+}
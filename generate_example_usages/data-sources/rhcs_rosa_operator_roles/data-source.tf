@@ -1,4 +1,13 @@
 data "rhcs_rosa_operator_roles" "operator_roles" {
   operator_role_prefix = "<operator-role-prefix>"
   account_role_prefix  = "<account-role-prefix>"
-}
\ No newline at end of file
+  account_id           = "<aws-account-id>"
+  oidc_endpoint_url    = "<oidc-endpoint-url>"
+}
+
+resource "aws_iam_role" "operator_roles" {
+  for_each             = { for role in data.rhcs_rosa_operator_roles.operator_roles.operator_iam_roles : role.role_name => role }
+  name                 = each.value.role_name
+  assume_role_policy   = each.value.assume_role_policy
+  permissions_boundary = each.value.permissions_boundary
+}
@@ -0,0 +1,4 @@
+data "rhcs_version_gates" "gates" {
+  from_version = "4.14.10"
+  to_version   = "4.15.3"
+}
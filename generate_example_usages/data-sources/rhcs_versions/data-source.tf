@@ -1 +1,7 @@
-data "rhcs_versions" "all" {}
\ No newline at end of file
+data "rhcs_versions" "all" {}
+
+data "rhcs_versions" "hcp_4_15" {
+  topology           = "hcp"
+  channel_group      = "stable"
+  version_constraint = "~> 4.15.0"
+}
@@ -0,0 +1,8 @@
+resource "rhcs_cluster_addon" "logging" {
+  cluster  = "cluster-id-123"
+  addon_id = "cluster-logging-operator"
+  parameters = {
+    "notification-email" = "admin@example.com"
+  }
+  wait_for_install_complete = true
+}
@@ -0,0 +1,13 @@
+resource "rhcs_cluster_upgrade_policy" "automatic" {
+  cluster                 = "cluster-id-123"
+  schedule_type           = "automatic"
+  schedule                = "0 2 * * 6"
+  node_drain_grace_period = 60
+}
+
+resource "rhcs_cluster_upgrade_policy" "manual" {
+  cluster       = "cluster-id-456"
+  schedule_type = "manual"
+  version       = "4.15.3"
+  next_run      = "2024-06-01T02:00:00Z"
+}
@@ -0,0 +1,7 @@
+data "rhcs_info" "current" {
+}
+
+resource "rhcs_ocm_role_link" "ocm_role" {
+  organization_id = data.rhcs_info.current.organization_id
+  role_arn        = "arn:aws:iam::<aws-account-id>:role/ManagedOpenShift-OCM-Role-${data.rhcs_info.current.organization_external_id}"
+}
@@ -2,3 +2,20 @@
 resource "rhcs_rosa_oidc_config_input" "oidc_input" {
   region = "us-east-2"
 }
+
+# Uses an existing private key and bucket name, so the discovery document and the JSON web key
+# set don't change if the resource is replaced or imported
+resource "rhcs_rosa_oidc_config_input" "oidc_input_with_key" {
+  region      = "us-east-2"
+  bucket_name = "my-oidc-bucket"
+  private_key = file("${path.module}/oidc-private-key.pem")
+}
+
+# Rotates the private key. Apply each phase in order, waiting between phases long enough for
+# the OIDC provider to refresh the keys and for tokens signed with the old key to expire:
+# "publish", "activate" and "retire". Then remove the phase to complete the rotation. The
+# secret with the private key should always store the value of 'signing_private_key'
+resource "rhcs_rosa_oidc_config_input" "oidc_input_rotation" {
+  region         = "us-east-2"
+  rotation_phase = "publish"
+}
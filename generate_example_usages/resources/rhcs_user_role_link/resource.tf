@@ -0,0 +1,7 @@
+data "rhcs_info" "current" {
+}
+
+resource "rhcs_user_role_link" "user_role" {
+  account_id = data.rhcs_info.current.account_id
+  role_arn   = "arn:aws:iam::<aws-account-id>:role/ManagedOpenShift-User-${data.rhcs_info.current.account_username}-Role"
+}
@@ -0,0 +1,10 @@
+data "rhcs_version_gates" "gates" {
+  from_version = "4.14.10"
+  to_version   = "4.15.3"
+}
+
+resource "rhcs_version_gate_agreement" "agreements" {
+  for_each        = { for gate in data.rhcs_version_gates.gates.items : gate.id => gate if !gate.sts_only }
+  cluster         = "cluster-id-123"
+  version_gate_id = each.key
+}
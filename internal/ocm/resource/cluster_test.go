@@ -307,6 +307,29 @@ var _ = Describe("Cluster", func() {
 			Expect(aws.PrivateHostedZoneID()).To(Equal(privateHZId))
 			Expect(aws.PrivateHostedZoneRoleARN()).To(Equal(privateHZRoleArn))
 		})
+		It("PrivateHostedZone set with a GovCloud role ARN - success", func() {
+			validKmsKey := "arn:aws-us-gov:kms:us-gov-west-1:111111111111:key/mrk-0123456789abcdef0123456789abcdef"
+			accountID := "111111111111"
+			subnets := []string{"subnet-1a1a1a1a1a1a1a1a1", "subnet-2b2b2b2b2b2b2b2b2", "subnet-3c3c3c3c3c3c3c3c3"}
+			installerRole := "arn:aws-us-gov:iam::111111111111:role/aaa-Installer-Role"
+			supportRole := "arn:aws-us-gov:iam::111111111111:role/aaa-Support-Role"
+			masterRole := "arn:aws-us-gov:iam::111111111111:role/aaa-ControlPlane-Role"
+			workerRole := "arn:aws-us-gov:iam::111111111111:role/aaa-Worker-Role"
+			privateHZRoleArn := "arn:aws-us-gov:iam::111111111111:role/aaa-hosted-zone-Role"
+			privateHZId := "123123"
+			operatorRolePrefix := "bbb"
+			oidcConfigID := "1234567dgsdfgh"
+			sts := CreateSTS(installerRole, supportRole, &masterRole, workerRole,
+				operatorRolePrefix, pointer(oidcConfigID))
+			err := cluster.CreateAWSBuilder(rosaTypes.Classic, map[string]string{"key1": "val1"},
+				pointer(string(cmv1.Ec2MetadataHttpTokensRequired)),
+				pointer(validKmsKey), nil, true, pointer(accountID), nil,
+				sts, subnets, &privateHZId, &privateHZRoleArn, nil, nil, nil, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			ocmCluster, err := cluster.Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ocmCluster.AWS().PrivateHostedZoneRoleARN()).To(Equal(privateHZRoleArn))
+		})
 		It("PrivateHostedZone set with invalid role ARN - fail", func() {
 			validKmsKey := "arn:aws:kms:us-east-1:111111111111:key/mrk-0123456789abcdef0123456789abcdef"
 			accountID := "111111111111"
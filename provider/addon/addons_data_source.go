@@ -0,0 +1,351 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type AddonsDataSource struct {
+	collection *cmv1.AddOnsClient
+}
+
+var _ datasource.DataSource = &AddonsDataSource{}
+var _ datasource.DataSourceWithConfigure = &AddonsDataSource{}
+
+func NewAddonsDataSource() datasource.DataSource {
+	return &AddonsDataSource{}
+}
+
+func (s *AddonsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_addons"
+}
+
+func (s *AddonsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of the add-ons that can be installed in clusters, for example with the " +
+			"'rhcs_cluster_addon' resource.",
+		Attributes: map[string]schema.Attribute{
+			"search": schema.StringAttribute{
+				Description: "Search criteria. Defaults to the enabled add-ons.",
+				Optional:    true,
+			},
+			"order": schema.StringAttribute{
+				Description: "Order criteria.",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Content of the list.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: s.itemAttributes(),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (s *AddonsDataSource) itemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique identifier of the add-on. This is what should be used in the " +
+				"'addon_id' attribute of the cluster add-on resource.",
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the add-on.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description of the add-on.",
+			Computed:    true,
+		},
+		"docs_link": schema.StringAttribute{
+			Description: "Link to the documentation of the add-on.",
+			Computed:    true,
+		},
+		"enabled": schema.BoolAttribute{
+			Description: "Indicates if the add-on can be installed.",
+			Computed:    true,
+		},
+		"install_mode": schema.StringAttribute{
+			Description: "Install mode of the add-on, for example 'own_namespace' or 'all_namespaces'.",
+			Computed:    true,
+		},
+		"operator_name": schema.StringAttribute{
+			Description: "Name of the operator installed by the add-on.",
+			Computed:    true,
+		},
+		"target_namespace": schema.StringAttribute{
+			Description: "Namespace where the add-on is installed.",
+			Computed:    true,
+		},
+		"version": schema.StringAttribute{
+			Description: "Current version of the add-on.",
+			Computed:    true,
+		},
+		"parameters": schema.ListNestedAttribute{
+			Description: "Parameters accepted by the add-on.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "Unique identifier of the parameter, used as key in the " +
+							"'parameters' attribute of the cluster add-on resource.",
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Description: "Name of the parameter.",
+						Computed:    true,
+					},
+					"description": schema.StringAttribute{
+						Description: "Description of the parameter.",
+						Computed:    true,
+					},
+					"value_type": schema.StringAttribute{
+						Description: "Type of the value of the parameter, for example 'string', 'number' or 'boolean'.",
+						Computed:    true,
+					},
+					"default_value": schema.StringAttribute{
+						Description: "Value of the parameter when it isn't set.",
+						Computed:    true,
+					},
+					"required": schema.BoolAttribute{
+						Description: "Indicates if the parameter must be set when installing the add-on.",
+						Computed:    true,
+					},
+					"editable": schema.BoolAttribute{
+						Description: "Indicates if the parameter can be changed after the installation.",
+						Computed:    true,
+					},
+					"validation": schema.StringAttribute{
+						Description: "Regular expression that the value of the parameter must match.",
+						Computed:    true,
+					},
+					"options": schema.ListAttribute{
+						Description: "Values that the parameter accepts, if it is limited to a set of values.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
+			Computed: true,
+		},
+		"requirements": schema.ListNestedAttribute{
+			Description: "Requirements that the cluster must satisfy to install the add-on.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "Unique identifier of the requirement.",
+						Computed:    true,
+					},
+					"resource": schema.StringAttribute{
+						Description: "Type of resource the requirement applies to, for example 'cluster' or 'machine_pool'.",
+						Computed:    true,
+					},
+					"enabled": schema.BoolAttribute{
+						Description: "Indicates if the requirement is enforced.",
+						Computed:    true,
+					},
+					"data": schema.StringAttribute{
+						Description: "Data of the requirement, in JSON format.",
+						Computed:    true,
+					},
+				},
+			},
+			Computed: true,
+		},
+		"required_operator_roles": schema.ListNestedAttribute{
+			Description: "Operator roles that must exist in the AWS account of STS clusters before " +
+				"installing the add-on.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Name of the credentials request of the operator role.",
+						Computed:    true,
+					},
+					"namespace": schema.StringAttribute{
+						Description: "Namespace of the service account of the operator.",
+						Computed:    true,
+					},
+					"service_account": schema.StringAttribute{
+						Description: "Service account that assumes the operator role.",
+						Computed:    true,
+					},
+					"policy_permissions": schema.ListAttribute{
+						Description: "AWS permissions that the policy of the operator role must grant.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
+			Computed: true,
+		},
+	}
+}
+
+func (s *AddonsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection := req.ProviderData.(*sdk.Connection)
+
+	// Get the collection of add-ons:
+	s.collection = connection.ClustersMgmt().V1().Addons()
+}
+
+func (s *AddonsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &AddonsState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch the complete list of add-ons:
+	search := "enabled = 't'"
+	if common.HasValue(state.Search) {
+		search = state.Search.ValueString()
+	}
+	listSize := 100
+	listPage := 1
+	listRequest := s.collection.List().Size(listSize).Search(search)
+	if common.HasValue(state.Order) {
+		listRequest.Order(state.Order.ValueString())
+	}
+	state.Items = []*AddonState{}
+	for {
+		listResponse, err := listRequest.SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't list add-ons",
+				err.Error(),
+			)
+			return
+		}
+		var populateErr error
+		listResponse.Items().Each(func(addon *cmv1.AddOn) bool {
+			item, err := addonToState(addon)
+			if err != nil {
+				populateErr = fmt.Errorf("can't populate state for add-on '%s': %v", addon.ID(), err)
+				return false
+			}
+			state.Items = append(state.Items, item)
+			return true
+		})
+		if populateErr != nil {
+			resp.Diagnostics.AddError(
+				"Can't populate add-on state",
+				populateErr.Error(),
+			)
+			return
+		}
+		if listResponse.Size() < listSize {
+			break
+		}
+		listPage++
+		listRequest.Page(listPage)
+	}
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func addonToState(addon *cmv1.AddOn) (*AddonState, error) {
+	result := &AddonState{
+		ID:                    types.StringValue(addon.ID()),
+		Name:                  common.EmptiableStringToStringType(addon.Name()),
+		Description:           common.EmptiableStringToStringType(addon.Description()),
+		DocsLink:              common.EmptiableStringToStringType(addon.DocsLink()),
+		Enabled:               types.BoolValue(addon.Enabled()),
+		InstallMode:           common.EmptiableStringToStringType(string(addon.InstallMode())),
+		OperatorName:          common.EmptiableStringToStringType(addon.OperatorName()),
+		TargetNamespace:       common.EmptiableStringToStringType(addon.TargetNamespace()),
+		Version:               common.EmptiableStringToStringType(addon.Version().ID()),
+		Parameters:            []*AddonParameterState{},
+		Requirements:          []*AddonRequirementState{},
+		RequiredOperatorRoles: []*AddonOperatorRoleState{},
+	}
+
+	for _, parameter := range addon.Parameters().Slice() {
+		options := make([]string, 0, len(parameter.Options()))
+		for _, option := range parameter.Options() {
+			options = append(options, option.Value())
+		}
+		optionsList, err := common.StringArrayToList(options)
+		if err != nil {
+			return nil, err
+		}
+		result.Parameters = append(result.Parameters, &AddonParameterState{
+			ID:           types.StringValue(parameter.ID()),
+			Name:         common.EmptiableStringToStringType(parameter.Name()),
+			Description:  common.EmptiableStringToStringType(parameter.Description()),
+			ValueType:    common.EmptiableStringToStringType(parameter.ValueType()),
+			DefaultValue: common.EmptiableStringToStringType(parameter.DefaultValue()),
+			Required:     types.BoolValue(parameter.Required()),
+			Editable:     types.BoolValue(parameter.Editable()),
+			Validation:   common.EmptiableStringToStringType(parameter.Validation()),
+			Options:      optionsList,
+		})
+	}
+
+	for _, requirement := range addon.Requirements() {
+		data := types.StringNull()
+		if len(requirement.Data()) > 0 {
+			raw, err := json.Marshal(requirement.Data())
+			if err != nil {
+				return nil, err
+			}
+			data = types.StringValue(string(raw))
+		}
+		result.Requirements = append(result.Requirements, &AddonRequirementState{
+			ID:       types.StringValue(requirement.ID()),
+			Resource: common.EmptiableStringToStringType(requirement.Resource()),
+			Enabled:  types.BoolValue(requirement.Enabled()),
+			Data:     data,
+		})
+	}
+
+	for _, credentialRequest := range addon.CredentialsRequests() {
+		permissions, err := common.StringArrayToList(credentialRequest.PolicyPermissions())
+		if err != nil {
+			return nil, err
+		}
+		result.RequiredOperatorRoles = append(result.RequiredOperatorRoles, &AddonOperatorRoleState{
+			Name:              types.StringValue(credentialRequest.Name()),
+			Namespace:         common.EmptiableStringToStringType(credentialRequest.Namespace()),
+			ServiceAccount:    common.EmptiableStringToStringType(credentialRequest.ServiceAccount()),
+			PolicyPermissions: permissions,
+		})
+	}
+
+	return result, nil
+}
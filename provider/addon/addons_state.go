@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type AddonsState struct {
+	Search types.String  `tfsdk:"search"`
+	Order  types.String  `tfsdk:"order"`
+	Items  []*AddonState `tfsdk:"items"`
+}
+
+type AddonState struct {
+	ID                    types.String              `tfsdk:"id"`
+	Name                  types.String              `tfsdk:"name"`
+	Description           types.String              `tfsdk:"description"`
+	DocsLink              types.String              `tfsdk:"docs_link"`
+	Enabled               types.Bool                `tfsdk:"enabled"`
+	InstallMode           types.String              `tfsdk:"install_mode"`
+	OperatorName          types.String              `tfsdk:"operator_name"`
+	TargetNamespace       types.String              `tfsdk:"target_namespace"`
+	Version               types.String              `tfsdk:"version"`
+	Parameters            []*AddonParameterState    `tfsdk:"parameters"`
+	Requirements          []*AddonRequirementState  `tfsdk:"requirements"`
+	RequiredOperatorRoles []*AddonOperatorRoleState `tfsdk:"required_operator_roles"`
+}
+
+type AddonParameterState struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	ValueType    types.String `tfsdk:"value_type"`
+	DefaultValue types.String `tfsdk:"default_value"`
+	Required     types.Bool   `tfsdk:"required"`
+	Editable     types.Bool   `tfsdk:"editable"`
+	Validation   types.String `tfsdk:"validation"`
+	Options      types.List   `tfsdk:"options"`
+}
+
+type AddonRequirementState struct {
+	ID       types.String `tfsdk:"id"`
+	Resource types.String `tfsdk:"resource"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Data     types.String `tfsdk:"data"`
+}
+
+type AddonOperatorRoleState struct {
+	Name              types.String `tfsdk:"name"`
+	Namespace         types.String `tfsdk:"namespace"`
+	ServiceAccount    types.String `tfsdk:"service_account"`
+	PolicyPermissions types.List   `tfsdk:"policy_permissions"`
+}
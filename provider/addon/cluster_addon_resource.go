@@ -0,0 +1,459 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+const (
+	defaultInstallWaitTimeoutInMinutes = int64(60)
+	installPollingInterval             = 30 * time.Second
+)
+
+type ClusterAddonResource struct {
+	collection *cmv1.ClustersClient
+}
+
+var _ resource.Resource = &ClusterAddonResource{}
+var _ resource.ResourceWithConfigure = &ClusterAddonResource{}
+var _ resource.ResourceWithImportState = &ClusterAddonResource{}
+
+func NewClusterAddonResource() resource.Resource {
+	return &ClusterAddonResource{}
+}
+
+func (r *ClusterAddonResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_addon"
+}
+
+func (r *ClusterAddonResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Installation of an add-on in a cluster. The add-ons that can be installed, and the " +
+			"parameters they accept, can be found with the 'rhcs_addons' data source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the add-on installation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster." + common.ValueCannotBeChangedStringDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"addon_id": schema.StringAttribute{
+				Description: "Identifier of the add-on, for example 'cluster-logging-operator'." +
+					common.ValueCannotBeChangedStringDescription,
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "add-on ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				Description: "Values of the parameters of the add-on, indexed by parameter identifier. " +
+					"Only the parameters set here are tracked, the rest keep the default values of the add-on.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the installed add-on.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the add-on installation, for example 'installing' or 'ready'.",
+				Computed:    true,
+			},
+			"state_description": schema.StringAttribute{
+				Description: "Reason of the current state of the add-on installation, if any.",
+				Computed:    true,
+			},
+			"wait_for_install_complete": schema.BoolAttribute{
+				Description: "Wait until the add-on is installed, and fail if the installation fails. " +
+					"Also applies to the updates of the parameters.",
+				Optional: true,
+			},
+			"max_install_wait_timeout_in_minutes": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum time to wait for the installation of the add-on, in minutes. "+
+					"Defaults to %d. Only used when 'wait_for_install_complete' is true.", defaultInstallWaitTimeoutInMinutes),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *ClusterAddonResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*sdk.Connection)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.collection = connection.ClustersMgmt().V1().Clusters()
+}
+
+func (r *ClusterAddonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &ClusterAddonState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := plan.Cluster.ValueString()
+	addonID := plan.AddonID.ValueString()
+	builder := cmv1.NewAddOnInstallation().
+		ID(addonID).
+		Addon(cmv1.NewAddOn().ID(addonID))
+	parameters, err := buildParameters(ctx, plan.Parameters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't build add-on installation",
+			fmt.Sprintf("Can't build installation of add-on '%s' in cluster '%s': %v", addonID, clusterID, err),
+		)
+		return
+	}
+	if parameters != nil {
+		builder.Parameters(parameters)
+	}
+	installation, err := builder.Build()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't build add-on installation",
+			fmt.Sprintf("Can't build installation of add-on '%s' in cluster '%s': %v", addonID, clusterID, err),
+		)
+		return
+	}
+	tflog.Debug(ctx, "Installing add-on", map[string]interface{}{
+		"cluster": clusterID,
+		"addon":   addonID,
+	})
+	add, err := r.collection.Cluster(clusterID).Addons().Add().Body(installation).SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't create add-on installation",
+			fmt.Sprintf("Can't install add-on '%s' in cluster '%s': %v",
+				addonID, clusterID, common.HandleErr(add.Error(), err)),
+		)
+		return
+	}
+	object := add.Body()
+
+	// Save the state before waiting, so that a failed installation is tainted instead of lost:
+	populateClusterAddonState(object, plan)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !common.BoolWithFalseDefault(plan.WaitForInstallComplete) {
+		return
+	}
+	object, err = r.waitForInstallation(ctx, plan)
+	if object != nil {
+		populateClusterAddonState(object, plan)
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't wait for add-on installation",
+			fmt.Sprintf("Can't wait for installation of add-on '%s' in cluster '%s': %v",
+				plan.ID.ValueString(), plan.Cluster.ValueString(), err),
+		)
+	}
+}
+
+func (r *ClusterAddonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &ClusterAddonState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	get, err := r.collection.Cluster(state.Cluster.ValueString()).Addons().
+		Addoninstallation(state.ID.ValueString()).Get().SendContext(ctx)
+	if err != nil {
+		if get.Status() == http.StatusNotFound {
+			tflog.Warn(ctx, "add-on installation not found, removing from state", map[string]interface{}{
+				"cluster": state.Cluster.ValueString(),
+				"id":      state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Can't find add-on installation",
+			fmt.Sprintf("Can't find installation of add-on '%s' in cluster '%s': %v",
+				state.ID.ValueString(), state.Cluster.ValueString(), err),
+		)
+		return
+	}
+
+	populateClusterAddonState(get.Body(), state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ClusterAddonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	state := &ClusterAddonState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	plan := &ClusterAddonState{}
+	diags = req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := state.Cluster.ValueString()
+	addonID := state.ID.ValueString()
+	object, err := r.updateParametersIfNeeded(ctx, state, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't update add-on installation",
+			fmt.Sprintf("Can't update installation of add-on '%s' in cluster '%s': %v", addonID, clusterID, err),
+		)
+		return
+	}
+	if object == nil {
+		get, err := r.collection.Cluster(clusterID).Addons().Addoninstallation(addonID).Get().SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't find add-on installation",
+				fmt.Sprintf("Can't find installation of add-on '%s' in cluster '%s': %v", addonID, clusterID, err),
+			)
+			return
+		}
+		object = get.Body()
+	}
+
+	populateClusterAddonState(object, plan)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !common.BoolWithFalseDefault(plan.WaitForInstallComplete) {
+		return
+	}
+	object, err = r.waitForInstallation(ctx, plan)
+	if object != nil {
+		populateClusterAddonState(object, plan)
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't wait for add-on installation",
+			fmt.Sprintf("Can't wait for installation of add-on '%s' in cluster '%s': %v",
+				plan.ID.ValueString(), plan.Cluster.ValueString(), err),
+		)
+	}
+}
+
+// updateParametersIfNeeded sends the new values of the parameters to the server when they were
+// changed, and returns the updated add-on installation, or nil if nothing was sent.
+func (r *ClusterAddonResource) updateParametersIfNeeded(ctx context.Context,
+	state, plan *ClusterAddonState) (*cmv1.AddOnInstallation, error) {
+	if _, ok := common.ShouldPatchMap(state.Parameters, plan.Parameters); !ok {
+		return nil, nil
+	}
+	parameters, err := buildParameters(ctx, plan.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	if parameters == nil {
+		// Removing the parameters from the configuration leaves the current values in place
+		return nil, nil
+	}
+	patch, err := cmv1.NewAddOnInstallation().Parameters(parameters).Build()
+	if err != nil {
+		return nil, err
+	}
+	update, err := r.collection.Cluster(state.Cluster.ValueString()).Addons().
+		Addoninstallation(state.ID.ValueString()).Update().Body(patch).SendContext(ctx)
+	if err != nil {
+		return nil, common.HandleErr(update.Error(), err)
+	}
+	return update.Body(), nil
+}
+
+func (r *ClusterAddonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &ClusterAddonState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remove, err := r.collection.Cluster(state.Cluster.ValueString()).Addons().
+		Addoninstallation(state.ID.ValueString()).Delete().SendContext(ctx)
+	if err != nil && remove.Status() != http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Can't delete add-on installation",
+			fmt.Sprintf("Can't uninstall add-on '%s' from cluster '%s': %v",
+				state.ID.ValueString(), state.Cluster.ValueString(), common.HandleErr(remove.Error(), err)),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ClusterAddonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// To import an add-on installation, we need to know the cluster ID and the add-on ID
+	fields := strings.Split(req.ID, ",")
+	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			"Add-on installation to import should be specified as <cluster_id>,<addon_id>",
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster"), fields[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fields[1])...)
+}
+
+// waitForInstallation polls the add-on installation until it is ready or failed, and returns the
+// last version of it that was retrieved.
+func (r *ClusterAddonResource) waitForInstallation(ctx context.Context,
+	state *ClusterAddonState) (*cmv1.AddOnInstallation, error) {
+	timeout := defaultInstallWaitTimeoutInMinutes
+	if common.HasValue(state.MaxInstallWaitTimeoutInMinutes) {
+		timeout = state.MaxInstallWaitTimeoutInMinutes.ValueInt64()
+	}
+	pollCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
+	defer cancel()
+	var object *cmv1.AddOnInstallation
+	_, err := r.collection.Cluster(state.Cluster.ValueString()).Addons().
+		Addoninstallation(state.ID.ValueString()).Poll().
+		Interval(installPollingInterval).
+		Predicate(func(get *cmv1.AddOnInstallationGetResponse) bool {
+			object = get.Body()
+			tflog.Debug(ctx, "polled add-on installation state", map[string]interface{}{
+				"state": object.State(),
+			})
+			switch object.State() {
+			case cmv1.AddOnInstallationStateReady, cmv1.AddOnInstallationStateFailed:
+				return true
+			}
+			return false
+		}).
+		StartContext(pollCtx)
+	if err != nil {
+		return object, err
+	}
+	if object.State() == cmv1.AddOnInstallationStateFailed {
+		return object, fmt.Errorf("installation failed: %s", object.StateDescription())
+	}
+	return object, nil
+}
+
+// buildParameters converts the parameters of the Terraform configuration to the list sent to the
+// server, or nil when there are none.
+func buildParameters(ctx context.Context, parameters types.Map) (*cmv1.AddOnInstallationParameterListBuilder, error) {
+	values, err := common.OptionalMap(ctx, parameters)
+	if err != nil {
+		return nil, err
+	}
+	if values == nil {
+		return nil, nil
+	}
+	items := make([]*cmv1.AddOnInstallationParameterBuilder, 0, len(values))
+	for id, value := range values {
+		items = append(items, cmv1.NewAddOnInstallationParameter().ID(id).Value(value))
+	}
+	return cmv1.NewAddOnInstallationParameterList().Items(items...), nil
+}
+
+// populateClusterAddonState copies the data from the add-on installation to the Terraform state.
+// The parameters are limited to the ones already in the state, as the server also returns the
+// default values of the ones that weren't set.
+func populateClusterAddonState(object *cmv1.AddOnInstallation, state *ClusterAddonState) {
+	state.ID = types.StringValue(object.ID())
+	if addonID, ok := object.Addon().GetID(); ok {
+		state.AddonID = types.StringValue(addonID)
+	} else if !common.HasValue(state.AddonID) {
+		state.AddonID = types.StringValue(object.ID())
+	}
+	state.Version = types.StringNull()
+	if version, ok := object.GetAddonVersion(); ok {
+		state.Version = types.StringValue(version.ID())
+	}
+	state.State = common.EmptiableStringToStringType(string(object.State()))
+	state.StateDescription = common.EmptiableStringToStringType(object.StateDescription())
+
+	if !common.HasValue(state.Parameters) {
+		return
+	}
+	elements := map[string]attr.Value{}
+	for id, value := range state.Parameters.Elements() {
+		elements[id] = value
+	}
+	object.Parameters().Each(func(parameter *cmv1.AddOnInstallationParameter) bool {
+		if _, ok := elements[parameter.ID()]; ok {
+			elements[parameter.ID()] = types.StringValue(parameter.Value())
+		}
+		return true
+	})
+	state.Parameters = types.MapValueMust(types.StringType, elements)
+}
@@ -0,0 +1,31 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ClusterAddonState struct {
+	ID                             types.String `tfsdk:"id"`
+	Cluster                        types.String `tfsdk:"cluster"`
+	AddonID                        types.String `tfsdk:"addon_id"`
+	Parameters                     types.Map    `tfsdk:"parameters"`
+	Version                        types.String `tfsdk:"version"`
+	State                          types.String `tfsdk:"state"`
+	StateDescription               types.String `tfsdk:"state_description"`
+	WaitForInstallComplete         types.Bool   `tfsdk:"wait_for_install_complete"`
+	MaxInstallWaitTimeoutInMinutes types.Int64  `tfsdk:"max_install_wait_timeout_in_minutes"`
+}
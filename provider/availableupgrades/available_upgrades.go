@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package availableupgrades
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+func itemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique identifier of the version, for example 'openshift-v4.15.2'.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Short name of the version, for example '4.15.2'.",
+			Computed:    true,
+		},
+		"requires_acknowledgement": schema.BoolAttribute{
+			Description: "Indicates if at least one of the version gates must be acknowledged, " +
+				"using the 'upgrade_acknowledgements_for' attribute, before upgrading to this version.",
+			Computed: true,
+		},
+		"version_gates": schema.ListNestedAttribute{
+			Description: "Version gates that have not been acknowledged yet for the upgrade to this version.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "Unique identifier of the version gate.",
+						Computed:    true,
+					},
+					"description": schema.StringAttribute{
+						Description: "Description of the version gate.",
+						Computed:    true,
+					},
+					"warning_message": schema.StringAttribute{
+						Description: "Warning message of the version gate.",
+						Computed:    true,
+					},
+					"documentation_url": schema.StringAttribute{
+						Description: "URL of the documentation of the version gate.",
+						Computed:    true,
+					},
+					"sts_only": schema.BoolAttribute{
+						Description: "Indicates if the version gate only applies to STS clusters.",
+						Computed:    true,
+					},
+					"requires_acknowledgement": schema.BoolAttribute{
+						Description: "Indicates if the version gate must be acknowledged by the user. " +
+							"STS only gates are acknowledged automatically.",
+						Computed: true,
+					},
+				},
+			},
+			Computed: true,
+		},
+	}
+}
+
+// checkMissingAgreementsFunc returns the version gates that haven't been acknowledged yet for the
+// upgrade to the given version.
+type checkMissingAgreementsFunc func(version string) ([]*cmv1.VersionGate, error)
+
+func versionsToState(versions []*cmv1.Version,
+	checkMissingAgreements checkMissingAgreementsFunc) ([]*AvailableUpgradeState, error) {
+	items := make([]*AvailableUpgradeState, len(versions))
+	for i, version := range versions {
+		gates, err := checkMissingAgreements(version.RawID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to check for missing upgrade agreements for version '%s': %v",
+				version.RawID(), err)
+		}
+		item := &AvailableUpgradeState{
+			ID:                      types.StringValue(version.ID()),
+			Name:                    types.StringValue(version.RawID()),
+			RequiresAcknowledgement: types.BoolValue(false),
+			VersionGates:            make([]*VersionGateState, len(gates)),
+		}
+		for j, gate := range gates {
+			// STS-only gates don't require user acknowledgement
+			requiresAck := !gate.STSOnly()
+			if requiresAck {
+				item.RequiresAcknowledgement = types.BoolValue(true)
+			}
+			item.VersionGates[j] = &VersionGateState{
+				ID:                      types.StringValue(gate.ID()),
+				Description:             common.EmptiableStringToStringType(gate.Description()),
+				WarningMessage:          common.EmptiableStringToStringType(gate.WarningMessage()),
+				DocumentationURL:        common.EmptiableStringToStringType(gate.DocumentationURL()),
+				STSOnly:                 types.BoolValue(gate.STSOnly()),
+				RequiresAcknowledgement: types.BoolValue(requiresAck),
+			}
+		}
+		items[i] = item
+	}
+	return items, nil
+}
+
+// versionName returns the short name of the version, for example '4.15.2', also when the server
+// didn't send the raw identifier.
+func versionName(version *cmv1.Version) string {
+	if rawID := version.RawID(); rawID != "" {
+		return rawID
+	}
+	id := strings.TrimSuffix(version.ID(), fmt.Sprintf("-%s", version.ChannelGroup()))
+	return strings.TrimPrefix(id, rosa.VersionPrefix)
+}
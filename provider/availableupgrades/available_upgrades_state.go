@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package availableupgrades
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ClusterAvailableUpgradesState struct {
+	Cluster        types.String             `tfsdk:"cluster"`
+	CurrentVersion types.String             `tfsdk:"current_version"`
+	Items          []*AvailableUpgradeState `tfsdk:"items"`
+}
+
+type MachinePoolAvailableUpgradesState struct {
+	Cluster        types.String             `tfsdk:"cluster"`
+	MachinePool    types.String             `tfsdk:"machine_pool"`
+	CurrentVersion types.String             `tfsdk:"current_version"`
+	Items          []*AvailableUpgradeState `tfsdk:"items"`
+}
+
+type AvailableUpgradeState struct {
+	ID                      types.String        `tfsdk:"id"`
+	Name                    types.String        `tfsdk:"name"`
+	RequiresAcknowledgement types.Bool          `tfsdk:"requires_acknowledgement"`
+	VersionGates            []*VersionGateState `tfsdk:"version_gates"`
+}
+
+type VersionGateState struct {
+	ID                      types.String `tfsdk:"id"`
+	Description             types.String `tfsdk:"description"`
+	WarningMessage          types.String `tfsdk:"warning_message"`
+	DocumentationURL        types.String `tfsdk:"documentation_url"`
+	STSOnly                 types.Bool   `tfsdk:"sts_only"`
+	RequiresAcknowledgement types.Bool   `tfsdk:"requires_acknowledgement"`
+}
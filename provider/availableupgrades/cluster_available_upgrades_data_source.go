@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package availableupgrades
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	classicUpgrade "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/classic/upgrade"
+	hcpUpgrade "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/hcp/upgrade"
+)
+
+type ClusterAvailableUpgradesDataSource struct {
+	clusterCollection *cmv1.ClustersClient
+	versionCollection *cmv1.VersionsClient
+}
+
+var _ datasource.DataSource = &ClusterAvailableUpgradesDataSource{}
+var _ datasource.DataSourceWithConfigure = &ClusterAvailableUpgradesDataSource{}
+
+func NewClusterDataSource() datasource.DataSource {
+	return &ClusterAvailableUpgradesDataSource{}
+}
+
+func (s *ClusterAvailableUpgradesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_available_upgrades"
+}
+
+func (s *ClusterAvailableUpgradesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of the versions that a cluster can be upgraded to.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+			},
+			"current_version": schema.StringAttribute{
+				Description: "Current version of the cluster, for example '4.15.2'.",
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Versions that the cluster can be upgraded to.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: itemAttributes(),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (s *ClusterAvailableUpgradesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection, ok := req.ProviderData.(*sdk.Connection)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	// Get the collections of clusters and versions:
+	s.clusterCollection = connection.ClustersMgmt().V1().Clusters()
+	s.versionCollection = connection.ClustersMgmt().V1().Versions()
+}
+
+func (s *ClusterAvailableUpgradesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &ClusterAvailableUpgradesState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch the cluster:
+	clusterID := state.Cluster.ValueString()
+	clusterClient := s.clusterCollection.Cluster(clusterID)
+	getResponse, err := clusterClient.Get().SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't find cluster",
+			fmt.Sprintf("Can't find cluster with identifier '%s': %v", clusterID, err),
+		)
+		return
+	}
+	cluster := getResponse.Body()
+
+	// Fetch the available upgrades, the way to calculate them and the version gates depends
+	// on the topology of the cluster:
+	var availableVersions []*cmv1.Version
+	var checkMissingAgreements checkMissingAgreementsFunc
+	if cluster.Hypershift().Enabled() {
+		availableVersions, err = hcpUpgrade.GetAvailableUpgradeVersions(
+			ctx, s.clusterCollection, s.versionCollection, clusterID)
+		checkMissingAgreements = func(version string) ([]*cmv1.VersionGate, error) {
+			gates, _, err := hcpUpgrade.CheckMissingAgreements(version, clusterID,
+				clusterClient.ControlPlane().UpgradePolicies())
+			return gates, err
+		}
+	} else {
+		availableVersions, err = classicUpgrade.GetAvailableUpgradeVersions(
+			ctx, s.versionCollection, cluster.Version().ID())
+		checkMissingAgreements = func(version string) ([]*cmv1.VersionGate, error) {
+			gates, _, err := classicUpgrade.CheckMissingAgreements(version, clusterID,
+				clusterClient.UpgradePolicies())
+			return gates, err
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't get available upgrades",
+			fmt.Sprintf("Can't get available upgrades for cluster '%s': %v", clusterID, err),
+		)
+		return
+	}
+
+	// Populate the state:
+	state.CurrentVersion = types.StringValue(versionName(cluster.Version()))
+	state.Items, err = versionsToState(availableVersions, checkMissingAgreements)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't get version gates",
+			fmt.Sprintf("Can't get version gates for cluster '%s': %v", clusterID, err),
+		)
+		return
+	}
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
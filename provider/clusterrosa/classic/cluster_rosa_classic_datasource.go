@@ -28,9 +28,8 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	rosaTypes "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common/types"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/sts"
-	"github.com/terraform-redhat/terraform-provider-rhcs/provider/proxy"
-
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/proxy"
 )
 
 var _ datasource.DataSource = &ClusterRosaClassicDatasource{}
@@ -42,6 +41,7 @@ type ClusterRosaClassicDatasource struct {
 	clusterCollection *cmv1.ClustersClient
 	versionCollection *cmv1.VersionsClient
 	clusterWait       common.ClusterWait
+	awsInquiries      *cmv1.AWSInquiriesClient
 }
 
 func NewDataSource() datasource.DataSource {
@@ -268,6 +268,26 @@ func (r *ClusterRosaClassicDatasource) Schema(ctx context.Context, req datasourc
 				Description: deprecatedMessage,
 				Computed:    true,
 			},
+			"upgrade_conflict_policy": schema.StringAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
+			"max_upgrade_wait_timeout_in_minutes": schema.Int64Attribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
+			"upgrade_preflight_checks": schema.BoolAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
+			"force_upgrade": schema.BoolAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
 			"create_admin_user": schema.BoolAttribute{
 				Description: deprecatedMessage,
 				Computed:    true,
@@ -287,10 +307,18 @@ func (r *ClusterRosaClassicDatasource) Schema(ctx context.Context, req datasourc
 				},
 				Computed: true,
 			},
+			"delete_protection": schema.BoolAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
 			"wait_for_create_complete": schema.BoolAttribute{
 				Description: deprecatedMessage,
 				Computed:    true,
 			},
+			"hibernate": schema.BoolAttribute{
+				Description: "True when the cluster is hibernating or powering down.",
+				Computed:    true,
+			},
 			"autoscaling_enabled": schema.BoolAttribute{
 				Description: deprecatedMessage,
 				Computed:    true,
@@ -346,6 +374,7 @@ func (r *ClusterRosaClassicDatasource) Configure(ctx context.Context, req dataso
 	r.clusterCollection = connection.ClustersMgmt().V1().Clusters()
 	r.versionCollection = connection.ClustersMgmt().V1().Versions()
 	r.clusterWait = common.NewClusterWait(r.clusterCollection)
+	r.awsInquiries = connection.ClustersMgmt().V1().AWSInquiries()
 }
 
 func (r *ClusterRosaClassicDatasource) Read(ctx context.Context, request datasource.ReadRequest,
@@ -380,9 +409,11 @@ func (r *ClusterRosaClassicDatasource) Read(ctx context.Context, request datasou
 	}
 
 	object := get.Body()
+	// The data source always reports whether the cluster is hibernating
+	state.Hibernate = types.BoolValue(false)
 
 	// Save the state:
-	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{})
+	err = populateRosaClassicClusterState(ctx, object, state, r.awsInquiries)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -50,7 +50,6 @@ import (
 	"github.com/openshift-online/ocm-common/pkg/ocm/consts"
 	ocmConsts "github.com/openshift-online/ocm-common/pkg/ocm/consts"
 	ocmUtils "github.com/openshift-online/ocm-common/pkg/ocm/utils"
-	"github.com/openshift-online/ocm-common/pkg/rosa/oidcconfigs"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	ocm_errors "github.com/openshift-online/ocm-sdk-go/errors"
@@ -79,6 +78,7 @@ type ClusterRosaClassicResource struct {
 
 var _ resource.ResourceWithConfigure = &ClusterRosaClassicResource{}
 var _ resource.ResourceWithImportState = &ClusterRosaClassicResource{}
+var _ resource.ResourceWithModifyPlan = &ClusterRosaClassicResource{}
 
 func New() resource.Resource {
 	return &ClusterRosaClassicResource{}
@@ -395,6 +395,30 @@ func (r *ClusterRosaClassicResource) Schema(ctx context.Context, req resource.Sc
 					"upgrade to OpenShift 4.12.z from 4.11 or before).",
 				Optional: true,
 			},
+			"upgrade_conflict_policy": schema.StringAttribute{
+				Description: common.UpgradeConflictPolicyDescription,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(common.UpgradeConflictPolicies...),
+				},
+			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Description: fmt.Sprintf("Wait until the upgrade to the requested version is either completed or failed. "+
+					"The waiter has a timeout of %d minutes, with the default value set to false", rosa.MaxUpgradeWaitTimeoutInMinutes),
+				Optional: true,
+			},
+			"max_upgrade_wait_timeout_in_minutes": schema.Int64Attribute{
+				Description: "This value sets the maximum duration in minutes to wait for an upgrade to complete.",
+				Optional:    true,
+			},
+			"upgrade_preflight_checks": schema.BoolAttribute{
+				Description: common.UpgradePreflightChecksDescription,
+				Optional:    true,
+			},
+			"force_upgrade": schema.BoolAttribute{
+				Description: common.ForceUpgradeDescription,
+				Optional:    true,
+			},
 			"create_admin_user": schema.BoolAttribute{
 				Description: "Indicates if create cluster admin user. Set it true to create cluster admin user with default username `cluster-admin` " +
 					"and generated password. It will be ignored if `admin_credentials` is set." + common.ValueCannotBeChangedStringDescription,
@@ -461,6 +485,21 @@ func (r *ClusterRosaClassicResource) Schema(ctx context.Context, req resource.Sc
 				Description: "This value sets the maximum duration in minutes to wait for the cluster to be in a ready state.",
 				Optional:    true,
 			},
+			"delete_protection": schema.BoolAttribute{
+				Description: common.DeleteProtectionDescription,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hibernate": schema.BoolAttribute{
+				Description: "Hibernates the cluster when `true`, and resumes it when set back to `false`. The provider " +
+					"waits for the cluster to be hibernating or ready, up to 'max_cluster_wait_timeout_in_minutes'. " +
+					"Setting it to `true` on creation requires 'wait_for_create_complete'. When not set, the " +
+					"hibernation state of the cluster isn't managed.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -483,6 +522,7 @@ func (r *ClusterRosaClassicResource) Configure(ctx context.Context, req resource
 	r.ClusterCollection = connection.ClustersMgmt().V1().Clusters()
 	r.VersionCollection = connection.ClustersMgmt().V1().Versions()
 	r.ClusterWait = common.NewClusterWait(r.ClusterCollection)
+	r.AWSInquiries = connection.ClustersMgmt().V1().AWSInquiries()
 }
 
 const (
@@ -565,6 +605,10 @@ func createClassicClusterObject(ctx context.Context,
 	}
 	builder.Properties(properties)
 
+	if common.HasValue(state.DeleteProtection) {
+		builder.DeleteProtection(cmv1.NewDeleteProtection().Enabled(state.DeleteProtection.ValueBool()))
+	}
+
 	if common.HasValue(state.EtcdEncryption) {
 		builder.EtcdEncryption(state.EtcdEncryption.ValueBool())
 	}
@@ -849,6 +893,9 @@ func (r *ClusterRosaClassicResource) Create(ctx context.Context, request resourc
 		return
 	}
 
+	// Populating the state of the created cluster replaces the requested hibernation:
+	hibernate := state.Hibernate.ValueBool()
+
 	object, err := createClassicClusterObject(ctx, state, diags)
 	if err != nil {
 		response.Diagnostics.AddError(
@@ -875,7 +922,7 @@ func (r *ClusterRosaClassicResource) Create(ctx context.Context, request resourc
 	object = add.Body()
 
 	// Save initial state:
-	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{})
+	err = populateRosaClassicClusterState(ctx, object, state, r.AWSInquiries)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -909,8 +956,23 @@ func (r *ClusterRosaClassicResource) Create(ctx context.Context, request resourc
 		}
 	}
 
+	if hibernate && !response.Diagnostics.HasError() {
+		object, err = r.hibernateCluster(ctx, state)
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Can't hibernate cluster",
+				fmt.Sprintf("Can't hibernate cluster with identifier '%s': %v", state.ID.ValueString(), err),
+			)
+			if object == nil {
+				diags = response.State.Set(ctx, state)
+				response.Diagnostics.Append(diags...)
+				return
+			}
+		}
+	}
+
 	// Save the state post wait completion:
-	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{})
+	err = populateRosaClassicClusterState(ctx, object, state, r.AWSInquiries)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -959,7 +1021,7 @@ func (r *ClusterRosaClassicResource) Read(ctx context.Context, request resource.
 	object := get.Body()
 
 	// Save the state:
-	err = populateRosaClassicClusterState(ctx, object, state, common.DefaultHttpClient{})
+	err = populateRosaClassicClusterState(ctx, object, state, r.AWSInquiries)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -1064,10 +1126,32 @@ func (r *ClusterRosaClassicResource) Update(ctx context.Context, request resourc
 		return
 	}
 
+	// A hibernating cluster is resumed before any other change is applied
+	if state.State.ValueString() == string(cmv1.ClusterStateHibernating) &&
+		common.HasValue(plan.Hibernate) && !plan.Hibernate.ValueBool() {
+		object, err := r.resumeCluster(ctx, plan)
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Can't resume cluster",
+				fmt.Sprintf("Can't resume cluster with identifier '%s': %v", state.ID.ValueString(), err),
+			)
+			return
+		}
+		state.State = types.StringValue(string(object.State()))
+	}
+
 	clusterState := "Unknown"
 	if common.HasValue(state.State) && state.State.ValueString() != "" {
 		clusterState = state.State.ValueString()
 	}
+	if clusterState == string(cmv1.ClusterStateHibernating) {
+		response.Diagnostics.AddError(
+			"Update cluster operation is only supported while cluster is ready",
+			"Update cluster operation is only supported while cluster is ready, cluster is hibernating. "+
+				"Set 'hibernate' to false to resume it before applying other changes",
+		)
+		return
+	}
 	if clusterState != string(cmv1.ClusterStateReady) {
 		response.Diagnostics.AddError(
 			"Update cluster operation is only supported while cluster is ready",
@@ -1123,6 +1207,24 @@ func (r *ClusterRosaClassicResource) Update(ctx context.Context, request resourc
 		}
 	}
 
+	// The delete protection has its own endpoint, and the cluster returned by the patch
+	// below may not reflect the change yet
+	updatedDeleteProtection := types.BoolNull()
+	if enabled, ok := common.ShouldPatchBool(state.DeleteProtection, plan.DeleteProtection); ok {
+		enabled, err = common.UpdateDeleteProtection(ctx, r.ClusterCollection, state.ID.ValueString(), enabled)
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Can't update cluster",
+				fmt.Sprintf(
+					"Can't update delete protection of cluster with identifier '%s': %v",
+					state.ID.ValueString(), err,
+				),
+			)
+			return
+		}
+		updatedDeleteProtection = types.BoolValue(enabled)
+	}
+
 	clusterSpec, err := clusterBuilder.Build()
 	if err != nil {
 		response.Diagnostics.AddError(
@@ -1151,8 +1253,19 @@ func (r *ClusterRosaClassicResource) Update(ctx context.Context, request resourc
 
 	object := update.Body()
 
+	if plan.Hibernate.ValueBool() {
+		object, err = r.hibernateCluster(ctx, plan)
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Can't hibernate cluster",
+				fmt.Sprintf("Can't hibernate cluster with identifier '%s': %v", state.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
 	// Update the state:
-	err = populateRosaClassicClusterState(ctx, object, plan, common.DefaultHttpClient{})
+	err = populateRosaClassicClusterState(ctx, object, plan, r.AWSInquiries)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -1162,11 +1275,111 @@ func (r *ClusterRosaClassicResource) Update(ctx context.Context, request resourc
 		)
 		return
 	}
+	if !updatedDeleteProtection.IsNull() {
+		plan.DeleteProtection = updatedDeleteProtection
+	}
 
 	diags = response.State.Set(ctx, plan)
 	response.Diagnostics.Append(diags...)
 }
 
+// hibernateCluster requests the hibernation of the cluster and waits for it to be hibernating.
+func (r *ClusterRosaClassicResource) hibernateCluster(ctx context.Context, plan *ClusterRosaClassicState) (*cmv1.Cluster, error) {
+	timeOut, err := common.ValidateTimeout(common.OptionalInt64(plan.MaxClusterWaitTimeoutInMinutes), rosa.MaxClusterWaitTimeoutInMinutes)
+	if err != nil {
+		return nil, err
+	}
+	tflog.Info(ctx, fmt.Sprintf("Hibernating cluster '%s'", plan.ID.ValueString()))
+	_, err = r.ClusterCollection.Cluster(plan.ID.ValueString()).Hibernate().SendContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.ClusterWait.WaitForClusterToBeHibernating(ctx, plan.ID.ValueString(), *timeOut)
+}
+
+// resumeCluster requests the resumption of a hibernating cluster and waits for it to be ready.
+func (r *ClusterRosaClassicResource) resumeCluster(ctx context.Context, plan *ClusterRosaClassicState) (*cmv1.Cluster, error) {
+	timeOut, err := common.ValidateTimeout(common.OptionalInt64(plan.MaxClusterWaitTimeoutInMinutes), rosa.MaxClusterWaitTimeoutInMinutes)
+	if err != nil {
+		return nil, err
+	}
+	tflog.Info(ctx, fmt.Sprintf("Resuming cluster '%s'", plan.ID.ValueString()))
+	_, err = r.ClusterCollection.Cluster(plan.ID.ValueString()).Resume().SendContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.ClusterWait.WaitForClusterToBeReady(ctx, plan.ID.ValueString(), *timeOut)
+}
+
+// ModifyPlan refuses to destroy or replace clusters with delete protection enabled, checks that
+// clusters are only hibernated on creation when waiting for them, and warns about the upgrades
+// scheduled outside of Terraform that conflict with the requested version.
+func (r *ClusterRosaClassicResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.CheckDeleteProtection(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateHibernateOnCreate(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	desiredVersion, ok, diags := common.PlannedUpgradeVersion(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if !ok {
+		return
+	}
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	var conflictPolicy types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("upgrade_conflict_policy"), &conflictPolicy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgrades, err := upgrade.GetScheduledUpgrades(ctx, r.ClusterCollection, id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Can't check conflicting upgrades",
+			fmt.Sprintf("Can't get the upgrade policies of cluster '%s': %v", id.ValueString(), err),
+		)
+		return
+	}
+	conflicts, err := upgrade.GetConflictingUpgrades(upgrades, desiredVersion)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Can't check conflicting upgrades",
+			fmt.Sprintf("Can't check the upgrade policies of cluster '%s': %v", id.ValueString(), err),
+		)
+		return
+	}
+	if len(conflicts) > 0 {
+		resp.Diagnostics.AddWarning(common.UpgradeConflictWarning(
+			conflictPolicy.ValueString(), conflicts, desiredVersion.String()))
+	}
+}
+
+// validateHibernateOnCreate checks that the clusters that are hibernated on creation also wait for
+// the creation to complete, as only ready clusters can be hibernated.
+func validateHibernateOnCreate(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var hibernate, waitForCreateComplete types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("hibernate"), &hibernate)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("wait_for_create_complete"), &waitForCreateComplete)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !common.BoolWithFalseDefault(hibernate) || waitForCreateComplete.IsUnknown() {
+		return
+	}
+	if !waitForCreateComplete.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("hibernate"), "Unexpected hibernate",
+			"Attribute 'hibernate' can only be `true` on creation when 'wait_for_create_complete' is `true`, "+
+				"as only ready clusters can be hibernated.")
+	}
+}
+
 // Upgrades the cluster if the desired (plan) version is greater than the
 // current version
 func (r *ClusterRosaClassicResource) upgradeClusterIfNeeded(ctx context.Context, state, plan *ClusterRosaClassicState) error {
@@ -1226,23 +1439,55 @@ func (r *ClusterRosaClassicResource) upgradeClusterIfNeeded(ctx context.Context,
 	if err != nil {
 		return fmt.Errorf("failed to get upgrade policies: %v", err)
 	}
+	upgradePolicyID := upgrade.GetPendingUpgradeID(upgrades, desiredVersion)
+
+	// Check the health of the cluster before scheduling a new upgrade
+	if !cancelingUpgradeOnly && upgradePolicyID == "" &&
+		common.BoolWithFalseDefault(plan.UpgradePreflightChecks) && !common.BoolWithFalseDefault(plan.ForceUpgrade) {
+		err = common.NewUpgradePreflight(r.ClusterCollection).Check(ctx, state.ID.ValueString(),
+			upgrade.GetInFlightUpgrades(upgrades, desiredVersion))
+		if err != nil {
+			return err
+		}
+	}
 
 	// Stop if an upgrade is already in progress
-	correctUpgradePending, err := upgrade.CheckAndCancelUpgrades(ctx, r.ClusterCollection, upgrades, desiredVersion)
+	correctUpgradePending, keptConflicts, err := upgrade.CheckAndCancelUpgrades(ctx, r.ClusterCollection, upgrades, desiredVersion, plan.UpgradeConflictPolicy.ValueString())
 	if err != nil {
 		return err
 	}
 
+	// Don't record the requested version as applied if the upgrade can't be scheduled because of
+	// the conflicting upgrades that were kept, so that it is requested again by the next apply
+	if !correctUpgradePending && !cancelingUpgradeOnly && len(keptConflicts) > 0 {
+		return common.KeptUpgradeConflictsError(keptConflicts, desiredVersion.String())
+	}
+
 	// Schedule a new upgrade
 	if !correctUpgradePending && !cancelingUpgradeOnly {
 		ackString := plan.UpgradeAcksFor.ValueString()
-		if err = scheduleUpgrade(ctx, r.ClusterCollection, state.ID.ValueString(), desiredVersion, ackString); err != nil {
+		upgradePolicyID, err = scheduleUpgrade(ctx, r.ClusterCollection, state.ID.ValueString(), desiredVersion, ackString)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Wait for the upgrade to complete
+	if common.BoolWithFalseDefault(plan.WaitForUpgradeComplete) && !cancelingUpgradeOnly && upgradePolicyID != "" {
+		timeOut, err := common.ValidateTimeout(
+			common.OptionalInt64(plan.MaxUpgradeWaitTimeoutInMinutes), rosa.MaxUpgradeWaitTimeoutInMinutes)
+		if err != nil {
+			return err
+		}
+		if err = upgrade.WaitForUpgradeToComplete(ctx, r.ClusterCollection,
+			state.ID.ValueString(), upgradePolicyID, *timeOut); err != nil {
 			return err
 		}
 	}
 
 	state.Version = plan.Version
 	state.UpgradeAcksFor = plan.UpgradeAcksFor
+	state.UpgradeConflictPolicy = plan.UpgradeConflictPolicy
 	return nil
 }
 
@@ -1285,7 +1530,7 @@ func (r *ClusterRosaClassicResource) validateUpgrade(ctx context.Context, state,
 }
 
 // Ensure user has acked upgrade gates and schedule the upgrade
-func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID string, desiredVersion *semver.Version, userAckString string) error {
+func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID string, desiredVersion *semver.Version, userAckString string) (string, error) {
 	// Gate agreements are checked when the upgrade is scheduled, resulting
 	// in an error return. ROSA cli does this by scheduling once w/ dryRun
 	// to look for un-acked agreements.
@@ -1293,7 +1538,7 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 	upgradePoliciesClient := clusterClient.UpgradePolicies()
 	gates, description, err := upgrade.CheckMissingAgreements(desiredVersion.String(), clusterID, upgradePoliciesClient)
 	if err != nil {
-		return fmt.Errorf("failed to check for missing upgrade agreements: %v", err)
+		return "", fmt.Errorf("failed to check for missing upgrade agreements: %v", err)
 	}
 	// User ack is required if we have any non-STS-only gates
 	userAckRequired := false
@@ -1304,7 +1549,7 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 	}
 	targetMinorVersion := getOcmVersionMinor(desiredVersion.String())
 	if userAckRequired && userAckString != targetMinorVersion { // User has not acknowledged mandatory gates, stop here.
-		return fmt.Errorf("%s\nTo acknowledge these items, please add \"upgrade_acknowledgements_for = %s\""+
+		return "", fmt.Errorf("%s\nTo acknowledge these items, please add \"upgrade_acknowledgements_for = %s\""+
 			" and re-apply the changes", description, targetMinorVersion)
 	}
 
@@ -1315,7 +1560,7 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 		gateAgreementsClient := clusterClient.GateAgreements()
 		err := upgrade.AckVersionGate(gateAgreementsClient, gateID)
 		if err != nil {
-			return fmt.Errorf("failed to acknowledge version gate '%s' for cluster '%s': %v",
+			return "", fmt.Errorf("failed to acknowledge version gate '%s' for cluster '%s': %v",
 				gateID, clusterID, err)
 		}
 	}
@@ -1328,16 +1573,16 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 		NextRun(tenMinFromNow).
 		Build()
 	if err != nil {
-		return fmt.Errorf("failed to create upgrade policy: %v", err)
+		return "", fmt.Errorf("failed to create upgrade policy: %v", err)
 	}
-	_, err = clusterClient.UpgradePolicies().
+	resp, err := clusterClient.UpgradePolicies().
 		Add().
 		Body(newPolicy).
 		SendContext(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to schedule upgrade: %v", err)
+		return "", fmt.Errorf("failed to schedule upgrade: %v", err)
 	}
-	return nil
+	return resp.Body().ID(), nil
 }
 
 func updateProxy(state, plan *ClusterRosaClassicState, clusterBuilder *cmv1.ClusterBuilder) (*cmv1.ClusterBuilder, error) {
@@ -1367,6 +1612,11 @@ func (r *ClusterRosaClassicResource) Delete(ctx context.Context, request resourc
 		return
 	}
 
+	if state.DeleteProtection.ValueBool() {
+		response.Diagnostics.Append(common.DeleteProtectionError(state.ID.ValueString()))
+		return
+	}
+
 	// Send the request to delete the cluster:
 	resource := r.ClusterCollection.Cluster(state.ID.ValueString())
 	_, err := resource.Delete().SendContext(ctx)
@@ -1424,7 +1674,8 @@ func (r *ClusterRosaClassicResource) ImportState(ctx context.Context, request re
 }
 
 // populateRosaClassicClusterState copies the data from the API object to the Terraform state.
-func populateRosaClassicClusterState(ctx context.Context, object *cmv1.Cluster, state *ClusterRosaClassicState, httpClient common.HttpClient) error {
+func populateRosaClassicClusterState(ctx context.Context, object *cmv1.Cluster, state *ClusterRosaClassicState,
+	awsInquiries *cmv1.AWSInquiriesClient) error {
 	state.ID = types.StringValue(object.ID())
 	state.ExternalID = types.StringValue(object.ExternalID())
 	object.API()
@@ -1552,7 +1803,7 @@ func populateRosaClassicClusterState(ctx context.Context, object *cmv1.Cluster,
 				state.Sts.OperatorRolePrefix = types.StringValue(operatorRolePrefix)
 			}
 		}
-		thumbprint, err := oidcconfigs.FetchThumbprint(stsState.OIDCEndpointURL())
+		thumbprint, err := common.GetOidcThumbprint(ctx, awsInquiries, common.ClusterOidcThumbprintInput(object))
 		if err != nil {
 			tflog.Error(ctx, fmt.Sprintf("cannot get thumbprint %v", err))
 			state.Sts.Thumbprint = types.StringValue("")
@@ -1699,6 +1950,11 @@ func populateRosaClassicClusterState(ctx context.Context, object *cmv1.Cluster,
 
 	}
 	state.State = types.StringValue(string(object.State()))
+	state.DeleteProtection = types.BoolValue(object.DeleteProtection().Enabled())
+	// The hibernation is only reported when it's managed, so clusters that don't set it never drift
+	if common.HasValue(state.Hibernate) {
+		state.Hibernate = types.BoolValue(isHibernating(object.State()))
+	}
 	state.Name = types.StringValue(object.Name())
 	state.CloudRegion = types.StringValue(object.Region().ID())
 	if state.AdminCredentials.IsUnknown() {
@@ -1708,6 +1964,11 @@ func populateRosaClassicClusterState(ctx context.Context, object *cmv1.Cluster,
 	return nil
 }
 
+// isHibernating returns true when the cluster is hibernating or on its way to it.
+func isHibernating(clusterState cmv1.ClusterState) bool {
+	return clusterState == cmv1.ClusterStateHibernating || clusterState == cmv1.ClusterStatePoweringDown
+}
+
 func (r *ClusterRosaClassicResource) retryClusterNotFoundWithTimeout(attempts int, sleep time.Duration, ctx context.Context, timeout int64,
 	resource *cmv1.ClusterClient) (bool, error) {
 	isNotFound, err := r.waitTillClusterIsNotFoundWithTimeout(ctx, timeout, resource)
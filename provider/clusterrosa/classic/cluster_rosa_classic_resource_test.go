@@ -18,11 +18,11 @@ package classic
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -39,12 +39,18 @@ import (
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/proxy"
 )
 
-type MockHttpClient struct {
-	response *http.Response
+type MockInquiriesTransport struct {
+	status int
+	body   string
 }
 
-func (c MockHttpClient) Get(url string) (resp *http.Response, err error) {
-	return c.response, nil
+func (t MockInquiriesTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: t.status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(t.body)),
+		Request:    request,
+	}, nil
 }
 
 const (
@@ -68,20 +74,14 @@ const (
 	httpProxy         = "http://proxy.com"
 	httpsProxy        = "https://proxy.com"
 	httpTokens        = "required"
+	thumbprint        = "9e99a48a9960b14926bb7f3b02e22da2b0ab7280"
 )
 
 var (
-	mockHttpClient = MockHttpClient{
-		response: &http.Response{
-			TLS: &tls.ConnectionState{
-				PeerCertificates: []*x509.Certificate{
-					{
-						Raw: []byte("nonce"),
-					},
-				},
-			},
-		},
-	}
+	mockAWSInquiries = cmv1.NewAWSInquiriesClient(MockInquiriesTransport{
+		status: http.StatusOK,
+		body:   `{"thumbprint": "` + thumbprint + `"}`,
+	}, "/api/clusters_mgmt/v1/aws_inquiries")
 )
 
 func generateBasicRosaClassicClusterJson() map[string]interface{} {
@@ -237,7 +237,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient)).To(Succeed())
+			Expect(populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockAWSInquiries)).To(Succeed())
 
 			Expect(clusterState.ID.ValueString()).To(Equal(clusterId))
 			Expect(clusterState.CloudRegion.ValueString()).To(Equal(regionId))
@@ -267,6 +267,7 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 			Expect(clusterState.AWSPrivateLink.ValueBool()).To(Equal(privateLink))
 			Expect(clusterState.Sts.OIDCEndpointURL.ValueString()).To(Equal(oidcEndpointUrl))
 			Expect(clusterState.Sts.RoleARN.ValueString()).To(Equal(roleArn))
+			Expect(clusterState.Sts.Thumbprint.ValueString()).To(Equal(thumbprint))
 			Expect(clusterState.Ec2MetadataHttpTokens.ValueString()).To(Equal(httpTokens))
 		})
 		It("Check trimming of oidc url with https perfix", func() {
@@ -281,22 +282,24 @@ var _ = Describe("Rosa Classic Sts cluster", func() {
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient)
+			err = populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockAWSInquiries)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(clusterState.Sts.OIDCEndpointURL.ValueString()).To(Equal("nonce.com"))
 		})
 
-		It("Throws an error when oidc_endpoint_url is an invalid url", func() {
+		It("Leaves the thumbprint empty when it can't be retrieved", func() {
 			clusterState := &ClusterRosaClassicState{}
-			clusterJson := generateBasicRosaClassicClusterJson()
-			clusterJson["aws"].(map[string]interface{})["sts"].(map[string]interface{})["oidc_endpoint_url"] = "invalid$url"
-			clusterJsonString, err := json.Marshal(clusterJson)
+			clusterJsonString, err := json.Marshal(generateBasicRosaClassicClusterJson())
 			Expect(err).ToNot(HaveOccurred())
 
 			clusterObject, err := cmv1.UnmarshalCluster(clusterJsonString)
 			Expect(err).ToNot(HaveOccurred())
 
-			err = populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, mockHttpClient)
+			failingAWSInquiries := cmv1.NewAWSInquiriesClient(MockInquiriesTransport{
+				status: http.StatusBadRequest,
+				body:   `{"kind": "Error", "reason": "Issuer isn't reachable"}`,
+			}, "/api/clusters_mgmt/v1/aws_inquiries")
+			err = populateRosaClassicClusterState(context.Background(), clusterObject, clusterState, failingAWSInquiries)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(clusterState.Sts.Thumbprint.ValueString()).To(Equal(""))
 		})
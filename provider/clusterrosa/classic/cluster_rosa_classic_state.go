@@ -74,10 +74,17 @@ type ClusterRosaClassicState struct {
 	PrivateHostedZone                         *rosaTypes.PrivateHostedZone `tfsdk:"private_hosted_zone"`
 	BaseDNSDomain                             types.String                 `tfsdk:"base_dns_domain"`
 
-	UpgradeAcksFor types.String `tfsdk:"upgrade_acknowledgements_for"`
+	UpgradeAcksFor                 types.String `tfsdk:"upgrade_acknowledgements_for"`
+	UpgradeConflictPolicy          types.String `tfsdk:"upgrade_conflict_policy"`
+	WaitForUpgradeComplete         types.Bool   `tfsdk:"wait_for_upgrade_complete"`
+	MaxUpgradeWaitTimeoutInMinutes types.Int64  `tfsdk:"max_upgrade_wait_timeout_in_minutes"`
+	UpgradePreflightChecks         types.Bool   `tfsdk:"upgrade_preflight_checks"`
+	ForceUpgrade                   types.Bool   `tfsdk:"force_upgrade"`
 
 	DisableWaitingInDestroy        types.Bool  `tfsdk:"disable_waiting_in_destroy"`
 	DestroyTimeout                 types.Int64 `tfsdk:"destroy_timeout"`
 	WaitForCreateComplete          types.Bool  `tfsdk:"wait_for_create_complete"`
 	MaxClusterWaitTimeoutInMinutes types.Int64 `tfsdk:"max_cluster_wait_timeout_in_minutes"`
+	Hibernate                      types.Bool  `tfsdk:"hibernate"`
+	DeleteProtection               types.Bool  `tfsdk:"delete_protection"`
 }
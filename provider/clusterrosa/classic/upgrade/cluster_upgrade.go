@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"time"
 
 	semver "github.com/hashicorp/go-version"
@@ -47,6 +48,10 @@ func (cu *ClusterUpgrade) NextRun() time.Time {
 	return cu.policy.NextRun()
 }
 
+func (cu *ClusterUpgrade) Description() string {
+	return common.DescribeUpgradeConflict(cu.policy.ID(), cu.policy.Version(), cu.policy.NextRun())
+}
+
 func (cu *ClusterUpgrade) Delete(ctx context.Context, client *cmv1.ClustersClient) error {
 	_, err := client.Cluster(cu.policy.ClusterID()).UpgradePolicies().UpgradePolicy(cu.policy.ID()).Delete().SendContext(ctx)
 	if err != nil {
@@ -65,17 +70,17 @@ func GetAvailableUpgradeVersions(ctx context.Context, client *cmv1.VersionsClien
 	}
 	version := resp.Body()
 
-	// Cycle through the available upgrades and find the ones that are ROSA enabled
-	availableUpgradeVersions := []*cmv1.Version{}
+	// Fetch the available upgrades and find the ones that are ROSA enabled
+	ids := []string{}
 	for _, v := range version.AvailableUpgrades() {
-		id := ocmUtils.CreateVersionId(v, version.ChannelGroup())
-		resp, err := client.Version(id).
-			Get().
-			Send()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get version information: %v", err)
-		}
-		availableVersion := resp.Body()
+		ids = append(ids, ocmUtils.CreateVersionId(v, version.ChannelGroup()))
+	}
+	versions, err := common.GetVersions(ctx, client, ids)
+	if err != nil {
+		return nil, err
+	}
+	availableUpgradeVersions := []*cmv1.Version{}
+	for _, availableVersion := range versions {
 		if availableVersion.ROSAEnabled() {
 			availableUpgradeVersions = append(availableUpgradeVersions, availableVersion)
 		}
@@ -130,37 +135,128 @@ func GetScheduledUpgrades(ctx context.Context, client *cmv1.ClustersClient, clus
 	return upgrades, nil
 }
 
-// Check the provided list of upgrades, canceling pending upgrades that are not
-// for the correct version, and returning an error if there is already an
-// upgrade in progress that is not for the desired version
-func CheckAndCancelUpgrades(ctx context.Context, client *cmv1.ClustersClient, upgrades []ClusterUpgrade, desiredVersion *semver.Version) (bool, error) {
+// Check the provided list of upgrades, handling the pending upgrades that are
+// not for the correct version according to the given conflict policy, and
+// returning an error if there is already an upgrade in progress that is not for
+// the desired version. Returns true if the upgrade to the desired version is
+// already pending, and the descriptions of the conflicting upgrades that were
+// kept because of the 'keep' policy.
+func CheckAndCancelUpgrades(ctx context.Context, client *cmv1.ClustersClient, upgrades []ClusterUpgrade,
+	desiredVersion *semver.Version, conflictPolicy string) (bool, []string, error) {
 	correctUpgradePending := false
+	keptConflicts := []string{}
 	tenMinFromNow := time.Now().UTC().Add(10 * time.Minute)
 
 	for _, upgrade := range upgrades {
 		tflog.Debug(ctx, fmt.Sprintf("Found existing upgrade policy to %s in state %s", upgrade.Version(), upgrade.State()))
 		toVersion, err := semver.NewVersion(upgrade.Version())
 		if err != nil {
-			return false, fmt.Errorf("failed to parse upgrade version: %v", err)
+			return false, nil, fmt.Errorf("failed to parse upgrade version: %v", err)
 		}
 		switch upgrade.State() {
 		case cmv1.UpgradePolicyStateValueDelayed, cmv1.UpgradePolicyStateValueStarted:
 			if !desiredVersion.Equal(toVersion) {
-				return false, fmt.Errorf("a cluster upgrade is already in progress")
+				return false, nil, fmt.Errorf("a cluster upgrade is already in progress")
 			}
 			correctUpgradePending = true
 		case cmv1.UpgradePolicyStateValuePending, cmv1.UpgradePolicyStateValueScheduled:
 			if desiredVersion.Equal(toVersion) && upgrade.NextRun().Before(tenMinFromNow) {
 				correctUpgradePending = true
-			} else {
-				// The upgrade is not one we want, so cancel it
+				continue
+			}
+			// The upgrade is not one we want
+			switch common.UpgradeConflictPolicy(conflictPolicy) {
+			case common.UpgradeConflictPolicyFail:
+				return false, nil, fmt.Errorf("%s conflicts with the requested version '%s'",
+					upgrade.Description(), desiredVersion)
+			case common.UpgradeConflictPolicyKeep:
+				tflog.Warn(ctx, fmt.Sprintf("Keeping conflicting %s", upgrade.Description()))
+				keptConflicts = append(keptConflicts, upgrade.Description())
+			default:
 				if err := upgrade.Delete(ctx, client); err != nil {
-					return false, fmt.Errorf("failed to delete upgrade policy: %v", err)
+					return false, nil, fmt.Errorf("failed to delete upgrade policy: %v", err)
 				}
 			}
 		}
 	}
-	return correctUpgradePending, nil
+	return correctUpgradePending, keptConflicts, nil
+}
+
+// Get the descriptions of the pending upgrades that are not for the desired
+// version, and that CheckAndCancelUpgrades would handle as conflicts
+func GetConflictingUpgrades(upgrades []ClusterUpgrade, desiredVersion *semver.Version) ([]string, error) {
+	conflicts := []string{}
+	tenMinFromNow := time.Now().UTC().Add(10 * time.Minute)
+	for _, upgrade := range upgrades {
+		if upgrade.State() != cmv1.UpgradePolicyStateValuePending &&
+			upgrade.State() != cmv1.UpgradePolicyStateValueScheduled {
+			continue
+		}
+		toVersion, err := semver.NewVersion(upgrade.Version())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse upgrade version: %v", err)
+		}
+		if desiredVersion.Equal(toVersion) && upgrade.NextRun().Before(tenMinFromNow) {
+			continue
+		}
+		conflicts = append(conflicts, upgrade.Description())
+	}
+	return conflicts, nil
+}
+
+// Get the descriptions of the upgrades to other versions than the desired one
+// that are in progress
+func GetInFlightUpgrades(upgrades []ClusterUpgrade, desiredVersion *semver.Version) []string {
+	inFlight := []string{}
+	for _, upgrade := range upgrades {
+		switch upgrade.State() {
+		case cmv1.UpgradePolicyStateValueDelayed, cmv1.UpgradePolicyStateValueStarted:
+			toVersion, err := semver.NewVersion(upgrade.Version())
+			if err == nil && desiredVersion.Equal(toVersion) {
+				continue
+			}
+			inFlight = append(inFlight, upgrade.Description())
+		}
+	}
+	return inFlight
+}
+
+// Get the identifier of the upgrade to the desired version that is in progress
+// or about to start, or an empty string if there is none
+func GetPendingUpgradeID(upgrades []ClusterUpgrade, desiredVersion *semver.Version) string {
+	tenMinFromNow := time.Now().UTC().Add(10 * time.Minute)
+	for _, upgrade := range upgrades {
+		toVersion, err := semver.NewVersion(upgrade.Version())
+		if err != nil || !desiredVersion.Equal(toVersion) {
+			continue
+		}
+		switch upgrade.State() {
+		case cmv1.UpgradePolicyStateValueDelayed, cmv1.UpgradePolicyStateValueStarted:
+			return upgrade.policy.ID()
+		case cmv1.UpgradePolicyStateValuePending, cmv1.UpgradePolicyStateValueScheduled:
+			if upgrade.NextRun().Before(tenMinFromNow) {
+				return upgrade.policy.ID()
+			}
+		}
+	}
+	return ""
+}
+
+// Wait for the upgrade of the given upgrade policy to complete
+func WaitForUpgradeToComplete(ctx context.Context, client *cmv1.ClustersClient,
+	clusterId string, policyId string, waitTimeoutMin int64) error {
+	policyClient := client.Cluster(clusterId).UpgradePolicies().UpgradePolicy(policyId).State()
+	return common.WaitForUpgradeToComplete(ctx, policyId, waitTimeoutMin,
+		func(ctx context.Context) (*cmv1.UpgradePolicyState, error) {
+			resp, err := policyClient.Get().SendContext(ctx)
+			if resp != nil && resp.Status() == http.StatusNotFound {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, common.HandleErr(resp.Error(), err)
+			}
+			return resp.Body(), nil
+		})
 }
 
 func AckVersionGate(
@@ -4,6 +4,7 @@ import (
 	"regexp"
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/build"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
 const (
@@ -18,6 +19,7 @@ const (
 	MaxHCPClusterWaitTimeoutInMinutes  = int64(45)
 	MaxClusterWaitTimeoutInMinutes     = int64(60)
 	MaxMachinePoolWaitTimeoutInMinutes = int64(60)
+	MaxUpgradeWaitTimeoutInMinutes     = int64(180)
 	DefaultPollingIntervalInMinutes    = 2
 	NonPositiveTimeoutSummary          = "Can't poll cluster state with a non-positive timeout"
 	NonPositiveTimeoutFormat           = "Can't poll state of cluster with identifier '%s', the timeout that was set is not a positive number"
@@ -26,7 +28,7 @@ const (
 	MaxClusterDomainPrefixLength = 15
 )
 
-var UserArnRE = regexp.MustCompile("^(arn:(?:aws|aws-us-gov|aws-cn):(?:iam|sts)::\\d{12}(?:|:(?:root|user|assumed-role|role)(?:\\/?.+\\/?)?)(?:\\/[0-9A-Za-z\\+\\.@_,-]{1,64}))$")
+var UserArnRE = regexp.MustCompile("^(arn:" + common.AwsPartitionRegexp + ":(?:iam|sts)::\\d{12}(?:|:(?:root|user|assumed-role|role)(?:\\/?.+\\/?)?)(?:\\/[0-9A-Za-z\\+\\.@_,-]{1,64}))$")
 
 var OCMProperties = map[string]string{
 	PropertyRosaTfVersion: build.Version,
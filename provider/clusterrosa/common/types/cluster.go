@@ -39,6 +39,7 @@ type BaseCluster struct {
 	ClusterCollection *cmv1.ClustersClient
 	VersionCollection *cmv1.VersionsClient
 	ClusterWait       common.ClusterWait
+	AWSInquiries      *cmv1.AWSInquiriesClient
 }
 
 // getAndValidateVersionInChannelGroup ensures that the cluster version is
@@ -32,16 +32,16 @@ import (
 	rosaTypes "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common/types"
 	sharedvpc "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/hcp/shared_vpc"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/sts"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/proxy"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/registry_config"
-
-	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
 type ClusterRosaHcpDatasource struct {
 	clusterCollection *cmv1.ClustersClient
 	versionCollection *cmv1.VersionsClient
 	clusterWait       common.ClusterWait
+	awsInquiries      *cmv1.AWSInquiriesClient
 }
 
 var _ datasource.DataSource = &ClusterRosaHcpDatasource{}
@@ -211,6 +211,26 @@ func (r *ClusterRosaHcpDatasource) Schema(ctx context.Context, req datasource.Sc
 				Description: deprecatedMessage,
 				Computed:    true,
 			},
+			"upgrade_conflict_policy": schema.StringAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
+			"max_upgrade_wait_timeout_in_minutes": schema.Int64Attribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
+			"upgrade_preflight_checks": schema.BoolAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
+			"force_upgrade": schema.BoolAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
 			"ec2_metadata_http_tokens": schema.StringAttribute{
 				Description: "This value determines which EC2 Instance Metadata Service mode to use for EC2 instances in the cluster." +
 					"This can be set as `optional` (IMDS v1 or v2) or `required` (IMDSv2 only). " + common.ValueCannotBeChangedStringDescription,
@@ -234,6 +254,10 @@ func (r *ClusterRosaHcpDatasource) Schema(ctx context.Context, req datasource.Sc
 				Description: deprecatedMessage,
 				Computed:    true,
 			},
+			"delete_protection": schema.BoolAttribute{
+				Description: deprecatedMessage,
+				Computed:    true,
+			},
 			"wait_for_create_complete": schema.BoolAttribute{
 				Description: deprecatedMessage,
 				Computed:    true,
@@ -311,6 +335,7 @@ func (r *ClusterRosaHcpDatasource) Configure(ctx context.Context, req datasource
 	r.clusterCollection = connection.ClustersMgmt().V1().Clusters()
 	r.versionCollection = connection.ClustersMgmt().V1().Versions()
 	r.clusterWait = common.NewClusterWait(r.clusterCollection)
+	r.awsInquiries = connection.ClustersMgmt().V1().AWSInquiries()
 }
 
 func (r *ClusterRosaHcpDatasource) Read(ctx context.Context, request datasource.ReadRequest,
@@ -346,7 +371,7 @@ func (r *ClusterRosaHcpDatasource) Read(ctx context.Context, request datasource.
 	object := get.Body()
 
 	// Save the state:
-	err = populateRosaHcpClusterState(ctx, object, state)
+	err = populateRosaHcpClusterState(ctx, object, state, r.awsInquiries)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -52,7 +52,6 @@ import (
 	"github.com/openshift-online/ocm-common/pkg/ocm/consts"
 	ocmConsts "github.com/openshift-online/ocm-common/pkg/ocm/consts"
 	ocmUtils "github.com/openshift-online/ocm-common/pkg/ocm/utils"
-	"github.com/openshift-online/ocm-common/pkg/rosa/oidcconfigs"
 	commonutils "github.com/openshift-online/ocm-common/pkg/utils"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -81,6 +80,7 @@ type ClusterRosaHcpResource struct {
 
 var _ resource.ResourceWithConfigure = &ClusterRosaHcpResource{}
 var _ resource.ResourceWithImportState = &ClusterRosaHcpResource{}
+var _ resource.ResourceWithModifyPlan = &ClusterRosaHcpResource{}
 
 func New() resource.Resource {
 	return &ClusterRosaHcpResource{}
@@ -323,6 +323,30 @@ func (r *ClusterRosaHcpResource) Schema(ctx context.Context, req resource.Schema
 					"upgrade to OpenShift 4.12.z from 4.11 or before).",
 				Optional: true,
 			},
+			"upgrade_conflict_policy": schema.StringAttribute{
+				Description: common.UpgradeConflictPolicyDescription,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(common.UpgradeConflictPolicies...),
+				},
+			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Description: fmt.Sprintf("Wait until the upgrade to the requested version is either completed or failed. "+
+					"The waiter has a timeout of %d minutes, with the default value set to false", rosa.MaxUpgradeWaitTimeoutInMinutes),
+				Optional: true,
+			},
+			"max_upgrade_wait_timeout_in_minutes": schema.Int64Attribute{
+				Description: "This value sets the maximum duration in minutes to wait for an upgrade to complete.",
+				Optional:    true,
+			},
+			"upgrade_preflight_checks": schema.BoolAttribute{
+				Description: common.UpgradePreflightChecksDescription,
+				Optional:    true,
+			},
+			"force_upgrade": schema.BoolAttribute{
+				Description: common.ForceUpgradeDescription,
+				Optional:    true,
+			},
 			"wait_for_create_complete": schema.BoolAttribute{
 				Description: "Wait until the cluster is either in a ready state or in an error state. The waiter has a timeout of 45 minutes, with the default value set to false",
 				Optional:    true,
@@ -407,6 +431,14 @@ func (r *ClusterRosaHcpResource) Schema(ctx context.Context, req resource.Schema
 					sharedvpc.HcpSharedVpcValidator,
 				},
 			},
+			"delete_protection": schema.BoolAttribute{
+				Description: common.DeleteProtectionDescription,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"aws_additional_allowed_principals": schema.ListAttribute{
 				Description: "AWS additional allowed principals.",
 				ElementType: types.StringType,
@@ -434,6 +466,7 @@ func (r *ClusterRosaHcpResource) Configure(ctx context.Context, req resource.Con
 	r.ClusterCollection = connection.ClustersMgmt().V1().Clusters()
 	r.VersionCollection = connection.ClustersMgmt().V1().Versions()
 	r.ClusterWait = common.NewClusterWait(r.ClusterCollection)
+	r.AWSInquiries = connection.ClustersMgmt().V1().AWSInquiries()
 }
 
 const (
@@ -516,6 +549,10 @@ func createHcpClusterObject(ctx context.Context,
 	}
 	builder.Properties(properties)
 
+	if common.HasValue(state.DeleteProtection) {
+		builder.DeleteProtection(cmv1.NewDeleteProtection().Enabled(state.DeleteProtection.ValueBool()))
+	}
+
 	if common.HasValue(state.EtcdEncryption) {
 		builder.EtcdEncryption(state.EtcdEncryption.ValueBool())
 	}
@@ -809,7 +846,7 @@ func (r *ClusterRosaHcpResource) Create(ctx context.Context, request resource.Cr
 	object = add.Body()
 
 	// Save initial state:
-	err = populateRosaHcpClusterState(ctx, object, state)
+	err = populateRosaHcpClusterState(ctx, object, state, r.AWSInquiries)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -868,7 +905,7 @@ func (r *ClusterRosaHcpResource) Create(ctx context.Context, request resource.Cr
 	}
 
 	// Save the state post wait completion:
-	err = populateRosaHcpClusterState(ctx, object, state)
+	err = populateRosaHcpClusterState(ctx, object, state, r.AWSInquiries)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -917,7 +954,7 @@ func (r *ClusterRosaHcpResource) Read(ctx context.Context, request resource.Read
 	object := get.Body()
 
 	// Save the state:
-	err = populateRosaHcpClusterState(ctx, object, state)
+	err = populateRosaHcpClusterState(ctx, object, state, r.AWSInquiries)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -1104,6 +1141,24 @@ func (r *ClusterRosaHcpResource) Update(ctx context.Context, request resource.Up
 		clusterBuilder.AWS(awsBuilder)
 	}
 
+	// The delete protection has its own endpoint, and the cluster returned by the patch
+	// below may not reflect the change yet
+	updatedDeleteProtection := types.BoolNull()
+	if enabled, ok := common.ShouldPatchBool(state.DeleteProtection, plan.DeleteProtection); ok {
+		enabled, err = common.UpdateDeleteProtection(ctx, r.ClusterCollection, state.ID.ValueString(), enabled)
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Can't update cluster",
+				fmt.Sprintf(
+					"Can't update delete protection of cluster with identifier '%s': %v",
+					state.ID.ValueString(), err,
+				),
+			)
+			return
+		}
+		updatedDeleteProtection = types.BoolValue(enabled)
+	}
+
 	clusterSpec, err := clusterBuilder.Build()
 	if err != nil {
 		response.Diagnostics.AddError(
@@ -1133,7 +1188,7 @@ func (r *ClusterRosaHcpResource) Update(ctx context.Context, request resource.Up
 	object := update.Body()
 
 	// Update the state:
-	err = populateRosaHcpClusterState(ctx, object, plan)
+	err = populateRosaHcpClusterState(ctx, object, plan, r.AWSInquiries)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Can't populate cluster state",
@@ -1143,11 +1198,56 @@ func (r *ClusterRosaHcpResource) Update(ctx context.Context, request resource.Up
 		)
 		return
 	}
+	if !updatedDeleteProtection.IsNull() {
+		plan.DeleteProtection = updatedDeleteProtection
+	}
 
 	diags = response.State.Set(ctx, plan)
 	response.Diagnostics.Append(diags...)
 }
 
+// ModifyPlan refuses to destroy or replace clusters with delete protection enabled, and warns
+// about the upgrades scheduled outside of Terraform that conflict with the requested version.
+func (r *ClusterRosaHcpResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.CheckDeleteProtection(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	desiredVersion, ok, diags := common.PlannedUpgradeVersion(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if !ok {
+		return
+	}
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	var conflictPolicy types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("upgrade_conflict_policy"), &conflictPolicy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgrades, err := upgrade.GetScheduledUpgrades(ctx, r.ClusterCollection, id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Can't check conflicting upgrades",
+			fmt.Sprintf("Can't get the upgrade policies of cluster '%s': %v", id.ValueString(), err),
+		)
+		return
+	}
+	conflicts, err := upgrade.GetConflictingUpgrades(upgrades, desiredVersion)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Can't check conflicting upgrades",
+			fmt.Sprintf("Can't check the upgrade policies of cluster '%s': %v", id.ValueString(), err),
+		)
+		return
+	}
+	if len(conflicts) > 0 {
+		resp.Diagnostics.AddWarning(common.UpgradeConflictWarning(
+			conflictPolicy.ValueString(), conflicts, desiredVersion.String()))
+	}
+}
+
 // Upgrades the cluster if the desired (plan) version is greater than the
 // current version
 func (r *ClusterRosaHcpResource) upgradeClusterIfNeeded(ctx context.Context, state, plan *ClusterRosaHcpState) error {
@@ -1203,24 +1303,56 @@ func (r *ClusterRosaHcpResource) upgradeClusterIfNeeded(ctx context.Context, sta
 	if err != nil {
 		return fmt.Errorf("failed to get upgrade policies: %v", err)
 	}
+	upgradePolicyID := upgrade.GetPendingUpgradeID(upgrades, desiredVersion)
+
+	// Check the health of the cluster before scheduling a new upgrade
+	if !cancelingUpgradeOnly && upgradePolicyID == "" &&
+		common.BoolWithFalseDefault(plan.UpgradePreflightChecks) && !common.BoolWithFalseDefault(plan.ForceUpgrade) {
+		err = common.NewUpgradePreflight(r.ClusterCollection).Check(ctx, state.ID.ValueString(),
+			upgrade.GetInFlightUpgrades(upgrades, desiredVersion))
+		if err != nil {
+			return err
+		}
+	}
 
 	// Stop if an upgrade is already in progress
-	correctUpgradePending, err := upgrade.CheckAndCancelUpgrades(
-		ctx, r.ClusterCollection, upgrades, desiredVersion)
+	correctUpgradePending, keptConflicts, err := upgrade.CheckAndCancelUpgrades(
+		ctx, r.ClusterCollection, upgrades, desiredVersion, plan.UpgradeConflictPolicy.ValueString())
 	if err != nil {
 		return err
 	}
 
+	// Don't record the requested version as applied if the upgrade can't be scheduled because of
+	// the conflicting upgrades that were kept, so that it is requested again by the next apply
+	if !correctUpgradePending && !cancelingUpgradeOnly && len(keptConflicts) > 0 {
+		return common.KeptUpgradeConflictsError(keptConflicts, desiredVersion.String())
+	}
+
 	// Schedule a new upgrade
 	if !correctUpgradePending && !cancelingUpgradeOnly {
 		ackString := plan.UpgradeAcksFor.ValueString()
-		if err = scheduleUpgrade(ctx, r.ClusterCollection, state.ID.ValueString(), desiredVersion, ackString); err != nil {
+		upgradePolicyID, err = scheduleUpgrade(ctx, r.ClusterCollection, state.ID.ValueString(), desiredVersion, ackString)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Wait for the upgrade to complete
+	if common.BoolWithFalseDefault(plan.WaitForUpgradeComplete) && !cancelingUpgradeOnly && upgradePolicyID != "" {
+		timeOut, err := common.ValidateTimeout(
+			common.OptionalInt64(plan.MaxUpgradeWaitTimeoutInMinutes), rosa.MaxUpgradeWaitTimeoutInMinutes)
+		if err != nil {
+			return err
+		}
+		if err = upgrade.WaitForUpgradeToComplete(ctx, r.ClusterCollection,
+			state.ID.ValueString(), upgradePolicyID, *timeOut); err != nil {
 			return err
 		}
 	}
 
 	state.Version = plan.Version
 	state.UpgradeAcksFor = plan.UpgradeAcksFor
+	state.UpgradeConflictPolicy = plan.UpgradeConflictPolicy
 	return nil
 }
 
@@ -1258,7 +1390,7 @@ func (r *ClusterRosaHcpResource) validateUpgrade(ctx context.Context, state, pla
 }
 
 // Ensure user has acked upgrade gates and schedule the upgrade
-func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID string, desiredVersion *semver.Version, userAckString string) error {
+func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID string, desiredVersion *semver.Version, userAckString string) (string, error) {
 	// Gate agreements are checked when the upgrade is scheduled, resulting
 	// in an error return. ROSA cli does this by scheduling once w/ dryRun
 	// to look for un-acked agreements.
@@ -1266,7 +1398,7 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 	upgradePoliciesClient := clusterClient.ControlPlane().UpgradePolicies()
 	gates, description, err := upgrade.CheckMissingAgreements(desiredVersion.String(), clusterID, upgradePoliciesClient)
 	if err != nil {
-		return fmt.Errorf("failed to check for missing upgrade agreements: %v", err)
+		return "", fmt.Errorf("failed to check for missing upgrade agreements: %v", err)
 	}
 	// User ack is required if we have any non-STS-only gates
 	userAckRequired := false
@@ -1277,7 +1409,7 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 	}
 	targetMinorVersion := getOcmVersionMinor(desiredVersion.String())
 	if userAckRequired && userAckString != targetMinorVersion { // User has not acknowledged mandatory gates, stop here.
-		return fmt.Errorf("%s\nTo acknowledge these items, please add \"upgrade_acknowledgements_for = %s\""+
+		return "", fmt.Errorf("%s\nTo acknowledge these items, please add \"upgrade_acknowledgements_for = %s\""+
 			" and re-apply the changes", description, targetMinorVersion)
 	}
 
@@ -1288,7 +1420,7 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 		gateAgreementsClient := clusterClient.GateAgreements()
 		err := upgrade.AckVersionGate(gateAgreementsClient, gateID)
 		if err != nil {
-			return fmt.Errorf("failed to acknowledge version gate '%s' for cluster '%s': %v",
+			return "", fmt.Errorf("failed to acknowledge version gate '%s' for cluster '%s': %v",
 				gateID, clusterID, err)
 		}
 	}
@@ -1301,16 +1433,16 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient, clusterID
 		NextRun(tenMinFromNow).
 		Build()
 	if err != nil {
-		return fmt.Errorf("failed to create upgrade policy: %v", err)
+		return "", fmt.Errorf("failed to create upgrade policy: %v", err)
 	}
-	_, err = clusterClient.ControlPlane().UpgradePolicies().
+	resp, err := clusterClient.ControlPlane().UpgradePolicies().
 		Add().
 		Body(newPolicy).
 		SendContext(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to schedule upgrade: %v", err)
+		return "", fmt.Errorf("failed to schedule upgrade: %v", err)
 	}
-	return nil
+	return resp.Body().ID(), nil
 }
 
 func updateProxy(state, plan *ClusterRosaHcpState, clusterBuilder *cmv1.ClusterBuilder) (*cmv1.ClusterBuilder, error) {
@@ -1340,6 +1472,11 @@ func (r *ClusterRosaHcpResource) Delete(ctx context.Context, request resource.De
 		return
 	}
 
+	if state.DeleteProtection.ValueBool() {
+		response.Diagnostics.Append(common.DeleteProtectionError(state.ID.ValueString()))
+		return
+	}
+
 	// Send the request to delete the cluster:
 	resource := r.ClusterCollection.Cluster(state.ID.ValueString())
 	_, err := resource.Delete().SendContext(ctx)
@@ -1397,7 +1534,8 @@ func (r *ClusterRosaHcpResource) ImportState(ctx context.Context, request resour
 }
 
 // populateRosaHcpClusterState copies the data from the API object to the Terraform state.
-func populateRosaHcpClusterState(ctx context.Context, object *cmv1.Cluster, state *ClusterRosaHcpState) error {
+func populateRosaHcpClusterState(ctx context.Context, object *cmv1.Cluster, state *ClusterRosaHcpState,
+	awsInquiries *cmv1.AWSInquiriesClient) error {
 	state.ID = types.StringValue(object.ID())
 	state.ExternalID = types.StringValue(object.ExternalID())
 	object.API()
@@ -1504,7 +1642,7 @@ func populateRosaHcpClusterState(ctx context.Context, object *cmv1.Cluster, stat
 				state.Sts.OperatorRolePrefix = types.StringValue(operatorRolePrefix)
 			}
 		}
-		thumbprint, err := oidcconfigs.FetchThumbprint(stsState.OIDCEndpointURL())
+		thumbprint, err := common.GetOidcThumbprint(ctx, awsInquiries, common.ClusterOidcThumbprintInput(object))
 		if err != nil {
 			tflog.Error(ctx, fmt.Sprintf("cannot get thumbprint %v", err))
 			state.Sts.Thumbprint = types.StringValue("")
@@ -1621,6 +1759,7 @@ func populateRosaHcpClusterState(ctx context.Context, object *cmv1.Cluster, stat
 
 	}
 	state.State = types.StringValue(string(object.State()))
+	state.DeleteProtection = types.BoolValue(object.DeleteProtection().Enabled())
 	state.Name = types.StringValue(object.Name())
 	state.CloudRegion = types.StringValue(object.Region().ID())
 	if state.AdminCredentials.IsUnknown() {
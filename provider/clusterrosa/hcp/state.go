@@ -50,9 +50,16 @@ type ClusterRosaHcpState struct {
 	WorkerDiskSize        types.Int64  `tfsdk:"worker_disk_size"`
 
 	// Version/Upgrade fields
-	Version        types.String `tfsdk:"version"`
-	CurrentVersion types.String `tfsdk:"current_version"`
-	UpgradeAcksFor types.String `tfsdk:"upgrade_acknowledgements_for"`
+	Version                        types.String `tfsdk:"version"`
+	CurrentVersion                 types.String `tfsdk:"current_version"`
+	UpgradeAcksFor                 types.String `tfsdk:"upgrade_acknowledgements_for"`
+	UpgradeConflictPolicy          types.String `tfsdk:"upgrade_conflict_policy"`
+	WaitForUpgradeComplete         types.Bool   `tfsdk:"wait_for_upgrade_complete"`
+	MaxUpgradeWaitTimeoutInMinutes types.Int64  `tfsdk:"max_upgrade_wait_timeout_in_minutes"`
+	UpgradePreflightChecks         types.Bool   `tfsdk:"upgrade_preflight_checks"`
+	ForceUpgrade                   types.Bool   `tfsdk:"force_upgrade"`
+
+	DeleteProtection types.Bool `tfsdk:"delete_protection"`
 
 	// Meta fields - not related to cluster spec
 	DisableWaitingInDestroy            types.Bool  `tfsdk:"disable_waiting_in_destroy"`
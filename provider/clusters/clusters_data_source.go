@@ -0,0 +1,215 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
+	rosaTypes "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common/types"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type ClustersDataSource struct {
+	collection *cmv1.ClustersClient
+}
+
+var _ datasource.DataSource = &ClustersDataSource{}
+var _ datasource.DataSourceWithConfigure = &ClustersDataSource{}
+
+func New() datasource.DataSource {
+	return &ClustersDataSource{}
+}
+
+func (s *ClustersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clusters"
+}
+
+func (s *ClustersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of clusters.",
+		Attributes: map[string]schema.Attribute{
+			"search": schema.StringAttribute{
+				Description: "Search criteria, for example \"region.id = 'us-east-1' and state = 'ready'\". " +
+					"When not set, all the clusters visible to the user are returned.",
+				Optional: true,
+			},
+			"order": schema.StringAttribute{
+				Description: "Order criteria, for example \"name asc\".",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Content of the list.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: s.itemAttributes(),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (s *ClustersDataSource) itemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique identifier of the cluster.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the cluster.",
+			Computed:    true,
+		},
+		"external_id": schema.StringAttribute{
+			Description: "Unique external identifier of the cluster.",
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: "State of the cluster.",
+			Computed:    true,
+		},
+		"version": schema.StringAttribute{
+			Description: "Current version of the cluster, for example '4.15.2'.",
+			Computed:    true,
+		},
+		"region": schema.StringAttribute{
+			Description: "Cloud provider region identifier, for example 'us-east-1'.",
+			Computed:    true,
+		},
+		"topology": schema.StringAttribute{
+			Description: fmt.Sprintf("Topology of the cluster, either '%s' or '%s' (hosted control plane).",
+				rosaTypes.Classic, rosaTypes.Hcp),
+			Computed: true,
+		},
+		"api_url": schema.StringAttribute{
+			Description: "URL of the API server.",
+			Computed:    true,
+		},
+		"console_url": schema.StringAttribute{
+			Description: "URL of the console.",
+			Computed:    true,
+		},
+	}
+}
+
+func (s *ClustersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection, ok := req.ProviderData.(*sdk.Connection)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	// Get the collection of clusters:
+	s.collection = connection.ClustersMgmt().V1().Clusters()
+}
+
+func (s *ClustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &ClustersState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch the list of clusters:
+	var listItems []*cmv1.Cluster
+	listSize := 100
+	listPage := 1
+	listRequest := s.collection.List().Size(listSize)
+	if common.HasValue(state.Search) {
+		listRequest.Search(state.Search.ValueString())
+	}
+	if common.HasValue(state.Order) {
+		listRequest.Order(state.Order.ValueString())
+	}
+	for {
+		listResponse, err := listRequest.SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't list clusters",
+				err.Error(),
+			)
+			return
+		}
+		if listItems == nil {
+			listItems = make([]*cmv1.Cluster, 0, listResponse.Total())
+		}
+		listResponse.Items().Each(func(listItem *cmv1.Cluster) bool {
+			listItems = append(listItems, listItem)
+			return true
+		})
+		if listResponse.Size() < listSize {
+			break
+		}
+		listPage++
+		listRequest.Page(listPage)
+	}
+
+	// Populate the state:
+	state.Items = make([]*ClusterState, len(listItems))
+	for i, listItem := range listItems {
+		state.Items[i] = clusterToItem(listItem)
+	}
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func clusterToItem(object *cmv1.Cluster) *ClusterState {
+	item := &ClusterState{
+		ID:         types.StringValue(object.ID()),
+		Name:       types.StringValue(object.Name()),
+		ExternalID: common.EmptiableStringToStringType(object.ExternalID()),
+		State:      types.StringValue(string(object.State())),
+		Version:    types.StringNull(),
+		Region:     common.EmptiableStringToStringType(object.Region().ID()),
+		Topology:   types.StringValue(string(rosaTypes.Classic)),
+		APIURL:     common.EmptiableStringToStringType(object.API().URL()),
+		ConsoleURL: common.EmptiableStringToStringType(object.Console().URL()),
+	}
+	if object.Hypershift().Enabled() {
+		item.Topology = types.StringValue(string(rosaTypes.Hcp))
+	}
+	if rawID, ok := object.Version().GetRawID(); ok && rawID != "" {
+		item.Version = types.StringValue(rawID)
+	} else if id, ok := object.Version().GetID(); ok {
+		// If the cluster uses a non-default channel group, it will have been
+		// appended to the version identifier:
+		channelGroup := object.Version().ChannelGroup()
+		id = strings.TrimSuffix(id, fmt.Sprintf("-%s", channelGroup))
+		item.Version = types.StringValue(strings.TrimPrefix(id, rosa.VersionPrefix))
+	}
+	return item
+}
@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type ClustersState struct {
+	Search types.String    `tfsdk:"search"`
+	Order  types.String    `tfsdk:"order"`
+	Items  []*ClusterState `tfsdk:"items"`
+}
+
+type ClusterState struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	ExternalID types.String `tfsdk:"external_id"`
+	State      types.String `tfsdk:"state"`
+	Version    types.String `tfsdk:"version"`
+	Region     types.String `tfsdk:"region"`
+	Topology   types.String `tfsdk:"topology"`
+	APIURL     types.String `tfsdk:"api_url"`
+	ConsoleURL types.String `tfsdk:"console_url"`
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	AwsPartition       = "aws"
+	AwsUsGovPartition  = "aws-us-gov"
+	AwsChinaPartition  = "aws-cn"
+	AwsPartitionRegexp = `(?:aws|aws-us-gov|aws-cn)`
+
+	// policyPartitionPlaceholder is the placeholder used by the policy documents returned by the
+	// server for the partition of the ARNs.
+	policyPartitionPlaceholder = "%{partition}"
+)
+
+var AwsPartitions = []string{AwsPartition, AwsUsGovPartition, AwsChinaPartition}
+
+var AwsPartitionDescription = fmt.Sprintf("AWS partition of the generated ARNs, one of %s. Defaults to '%s'.",
+	strings.Join(AwsPartitions, ", "), AwsPartition)
+
+func AwsPartitionValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.OneOf(AwsPartitions...),
+	}
+}
+
+// PartitionForRegion returns the AWS partition that contains the given region.
+func PartitionForRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "us-gov-"):
+		return AwsUsGovPartition
+	case strings.HasPrefix(region, "cn-"):
+		return AwsChinaPartition
+	default:
+		return AwsPartition
+	}
+}
+
+// ArnWithPartition returns the given ARN moved to the given partition. Values that aren't valid
+// ARNs are returned unchanged.
+func ArnWithPartition(value, partition string) string {
+	parsed, err := arn.Parse(value)
+	if err != nil {
+		return value
+	}
+	parsed.Partition = partition
+	return parsed.String()
+}
+
+// PolicyWithPartition replaces the partition of the ARNs of a policy document, either given as
+// a placeholder or as the commercial partition, with the given partition.
+func PolicyWithPartition(document, partition string) string {
+	document = strings.ReplaceAll(document, policyPartitionPlaceholder, partition)
+	if partition == AwsPartition {
+		return document
+	}
+	return strings.ReplaceAll(document, "arn:aws:", fmt.Sprintf("arn:%s:", partition))
+}
+
+// BuildIamArn builds the ARN of an IAM resource, for example a role or a policy, of the given
+// account. The path, if any, must start and end with a slash.
+func BuildIamArn(partition, accountID, resourceType, path, name string) string {
+	if partition == "" {
+		partition = AwsPartition
+	}
+	if path == "" {
+		path = "/"
+	}
+	return fmt.Sprintf("arn:%s:iam::%s:%s%s%s", partition, accountID, resourceType, path, name)
+}
@@ -0,0 +1,35 @@
+package common
+
+import (
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+)
+
+var _ = Describe("AWS partition helpers", func() {
+	It("Finds the partition of a region", func() {
+		Expect(PartitionForRegion("us-east-1")).To(Equal(AwsPartition))
+		Expect(PartitionForRegion("us-gov-west-1")).To(Equal(AwsUsGovPartition))
+		Expect(PartitionForRegion("cn-north-1")).To(Equal(AwsChinaPartition))
+	})
+
+	It("Moves an ARN to another partition", func() {
+		Expect(ArnWithPartition("arn:aws:iam::aws:policy/service-role/ROSAWorkerInstancePolicy", AwsUsGovPartition)).
+			To(Equal("arn:aws-us-gov:iam::aws:policy/service-role/ROSAWorkerInstancePolicy"))
+		Expect(ArnWithPartition("not-an-arn", AwsUsGovPartition)).To(Equal("not-an-arn"))
+	})
+
+	It("Replaces the partition of a policy document", func() {
+		document := `{"Resource": ["arn:aws:iam::*:role/*", "arn:%{partition}:s3:::bucket"]}`
+		Expect(PolicyWithPartition(document, AwsPartition)).
+			To(Equal(`{"Resource": ["arn:aws:iam::*:role/*", "arn:aws:s3:::bucket"]}`))
+		Expect(PolicyWithPartition(document, AwsChinaPartition)).
+			To(Equal(`{"Resource": ["arn:aws-cn:iam::*:role/*", "arn:aws-cn:s3:::bucket"]}`))
+	})
+
+	It("Builds IAM ARNs", func() {
+		Expect(BuildIamArn("", "123456789012", "role", "", "my-role")).
+			To(Equal("arn:aws:iam::123456789012:role/my-role"))
+		Expect(BuildIamArn(AwsUsGovPartition, "123456789012", "policy", "/rosa/", "my-policy")).
+			To(Equal("arn:aws-us-gov:iam::123456789012:policy/rosa/my-policy"))
+	})
+})
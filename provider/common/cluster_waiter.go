@@ -16,6 +16,7 @@ const pollingIntervalInMinutes = 2
 type ClusterWait interface {
 	WaitForClusterToBeReady(ctx context.Context, clusterId string, waitTimeoutMin int64) (*cmv1.Cluster, error)
 	WaitForStdComputeNodesToBeReady(ctx context.Context, clusterId string, waitTimeoutMin int64) (*cmv1.Cluster, error)
+	WaitForClusterToBeHibernating(ctx context.Context, clusterId string, waitTimeoutMin int64) (*cmv1.Cluster, error)
 }
 
 type DefaultClusterWait struct {
@@ -67,13 +68,24 @@ func (dw *DefaultClusterWait) WaitForStdComputeNodesToBeReady(ctx context.Contex
 }
 
 func (dw *DefaultClusterWait) WaitForClusterToBeReady(ctx context.Context, clusterId string, waitTimeoutMin int64) (*cmv1.Cluster, error) {
+	return dw.waitForClusterState(ctx, clusterId, waitTimeoutMin, cmv1.ClusterStateReady)
+}
+
+func (dw *DefaultClusterWait) WaitForClusterToBeHibernating(ctx context.Context, clusterId string, waitTimeoutMin int64) (*cmv1.Cluster, error) {
+	return dw.waitForClusterState(ctx, clusterId, waitTimeoutMin, cmv1.ClusterStateHibernating)
+}
+
+// waitForClusterState waits till the cluster reaches the target state, and returns an error if it
+// doesn't reach it within the timeout.
+func (dw *DefaultClusterWait) waitForClusterState(ctx context.Context, clusterId string, waitTimeoutMin int64,
+	targetState cmv1.ClusterState) (*cmv1.Cluster, error) {
 	resource := dw.collection.Cluster(clusterId)
 
 	// First try to get the cluster and check its state
 	// Return an error in case:
 	// * Cluster not found
-	// * Cluster found but its state is "ERROR" or "UNINSTALLING" (will never become to "READY")
-	// In case the state is "READY" return the cluster
+	// * Cluster found but its state is "ERROR" or "UNINSTALLING" (will never reach the target state)
+	// In case the state is the target state return the cluster
 	resp, err := resource.Get().SendContext(ctx)
 	if err != nil && resp.Status() == http.StatusNotFound {
 		message := fmt.Sprintf("Failed to get Cluster '%s', with error: %v", clusterId, err)
@@ -82,23 +94,23 @@ func (dw *DefaultClusterWait) WaitForClusterToBeReady(ctx context.Context, clust
 	}
 	currentState := resp.Body().State()
 	if currentState == cmv1.ClusterStateError || currentState == cmv1.ClusterStateUninstalling {
-		message := fmt.Sprintf("Cluster '%s' is in state '%s' and will not become ready", clusterId, currentState)
+		message := fmt.Sprintf("Cluster '%s' is in state '%s' and will not become %s", clusterId, currentState, targetState)
 		tflog.Error(ctx, message)
 		return resp.Body(), fmt.Errorf(message)
 	}
-	if currentState == cmv1.ClusterStateReady {
-		tflog.Info(ctx, fmt.Sprintf("WaitForClusterToBeReady: Cluster '%s' is with state \"READY\"", clusterId))
+	if currentState == targetState {
+		tflog.Info(ctx, fmt.Sprintf("waitForClusterState: Cluster '%s' is with state '%s'", clusterId, targetState))
 		return resp.Body(), nil
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("WaitForClusterToBeReady: Cluster '%s' is with state '%s', Wait for the state to become 'READY' with timeout %d minutes",
-		clusterId, currentState, waitTimeoutMin))
+	tflog.Info(ctx, fmt.Sprintf("waitForClusterState: Cluster '%s' is with state '%s', Wait for the state to become '%s' with timeout %d minutes",
+		clusterId, currentState, targetState, waitTimeoutMin))
 
 	backoffAttempts := 3
 	backoffSleep := 30 * time.Second
 	var cluster *cmv1.Cluster
 	for cluster == nil {
-		cluster, err = pollClusterState(clusterId, ctx, waitTimeoutMin, dw.collection)
+		cluster, err = pollClusterState(clusterId, ctx, waitTimeoutMin, dw.collection, targetState)
 		if err != nil {
 			backoffAttempts--
 			if backoffAttempts == 0 {
@@ -108,11 +120,11 @@ func (dw *DefaultClusterWait) WaitForClusterToBeReady(ctx context.Context, clust
 		}
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("WaitForClusterToBeReady: Wait done for cluster '%s' with state '%s'", clusterId, currentState))
+	tflog.Info(ctx, fmt.Sprintf("waitForClusterState: Wait done for cluster '%s' with state '%s'", clusterId, cluster.State()))
 
-	// If Cluster is ready without ERROR
+	// If Cluster reached the target state without ERROR
 	// Otherwise return with ERROR
-	if cluster.State() == cmv1.ClusterStateReady {
+	if cluster.State() == targetState {
 		return cluster, nil
 	}
 	return cluster, fmt.Errorf("cluster '%s' is in state '%s'", clusterId, cluster.State())
@@ -145,7 +157,10 @@ func pollClusterCurrentCompute(clusterId string, ctx context.Context, timeout in
 	return object, nil
 }
 
-func pollClusterState(clusterId string, ctx context.Context, timeout int64, clusterCollection *cmv1.ClustersClient) (*cmv1.Cluster, error) {
+// pollClusterState polls the cluster until it reaches the target state, or a state it can't
+// leave by itself.
+func pollClusterState(clusterId string, ctx context.Context, timeout int64, clusterCollection *cmv1.ClustersClient,
+	targetState cmv1.ClusterState) (*cmv1.Cluster, error) {
 	client := clusterCollection.Cluster(clusterId)
 	var object *cmv1.Cluster
 	pollCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
@@ -158,7 +173,7 @@ func pollClusterState(clusterId string, ctx context.Context, timeout int64, clus
 				"state": object.State(),
 			})
 			switch object.State() {
-			case cmv1.ClusterStateReady,
+			case targetState,
 				cmv1.ClusterStateError,
 				cmv1.ClusterStateUninstalling:
 				return true
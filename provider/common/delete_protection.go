@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const (
+	DeleteProtectionDescription = "Prevents the cluster from being deleted, either by a destroy or by a replacement, " +
+		"until it is set back to `false`. Reflects the delete protection of the cluster in OCM when not set."
+	deleteProtectionSummary = "Cluster is protected from deletion"
+	deleteProtectionFormat  = "Cluster '%s' has delete protection enabled and can't be %s. " +
+		"Set 'delete_protection = false' and apply the change first."
+)
+
+// CheckDeleteProtection fails the plan when it destroys a cluster that has delete protection
+// enabled, so the error is reported before anything else is changed. Replacements aren't visible
+// here, as the replacement of a resource is planned as a separate create, so they are refused by the
+// Delete method of the resources with DeleteProtectionError instead.
+func CheckDeleteProtection(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || !req.Plan.Raw.IsNull() {
+		return
+	}
+	var id types.String
+	var deleteProtection types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("delete_protection"), &deleteProtection)...)
+	if resp.Diagnostics.HasError() || !deleteProtection.ValueBool() {
+		return
+	}
+	resp.Diagnostics.AddError(deleteProtectionSummary, fmt.Sprintf(deleteProtectionFormat, id.ValueString(), "destroyed"))
+}
+
+// DeleteProtectionError returns the diagnostic used to refuse the deletion of a protected cluster,
+// either by a destroy or by a replacement.
+func DeleteProtectionError(clusterID string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(deleteProtectionSummary,
+		fmt.Sprintf(deleteProtectionFormat, clusterID, "destroyed or replaced"))
+}
+
+// UpdateDeleteProtection changes the delete protection of the cluster, and returns the new value.
+func UpdateDeleteProtection(ctx context.Context, collection *cmv1.ClustersClient, clusterID string, enabled bool) (bool, error) {
+	body, err := cmv1.NewDeleteProtection().Enabled(enabled).Build()
+	if err != nil {
+		return false, err
+	}
+	resp, err := collection.Cluster(clusterID).DeleteProtection().Update().Body(body).SendContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	// The response doesn't always include the updated delete protection
+	if updated, ok := resp.GetBody(); ok {
+		return updated.Enabled(), nil
+	}
+	return enabled, nil
+}
@@ -165,3 +165,13 @@ func GetJsonStringOrNullString[T any](value *T) string {
 	}
 	return fmt.Sprintf("%+v", *value)
 }
+
+// ContainsAllLabels checks if every key/value pair of the given selector is present in the labels.
+func ContainsAllLabels(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if current, ok := labels[key]; !ok || current != value {
+			return false
+		}
+	}
+	return true
+}
@@ -50,4 +50,27 @@ var _ = Describe("Helper function tests", func() {
 			Expect(ok).ToNot(BeTrue())
 		})
 	})
+
+	Context("ContainsAllLabels", func() {
+		labels := map[string]string{
+			"key1": "val1",
+			"key2": "val2",
+		}
+
+		It("Should return true when the selector is empty", func() {
+			Expect(ContainsAllLabels(labels, nil)).To(BeTrue())
+			Expect(ContainsAllLabels(nil, map[string]string{})).To(BeTrue())
+		})
+
+		It("Should return true when all the selector labels are present", func() {
+			Expect(ContainsAllLabels(labels, map[string]string{"key1": "val1"})).To(BeTrue())
+			Expect(ContainsAllLabels(labels, labels)).To(BeTrue())
+		})
+
+		It("Should return false when a selector label is missing or different", func() {
+			Expect(ContainsAllLabels(labels, map[string]string{"key3": "val3"})).To(BeFalse())
+			Expect(ContainsAllLabels(labels, map[string]string{"key1": "val2"})).To(BeFalse())
+			Expect(ContainsAllLabels(nil, map[string]string{"key1": "val1"})).To(BeFalse())
+		})
+	})
 })
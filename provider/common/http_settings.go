@@ -0,0 +1,94 @@
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// HttpSettings contains the settings of the provider that apply to all the HTTP requests sent to
+// the OCM API.
+type HttpSettings struct {
+	// ProxyURL is the URL of the proxy used for all the requests. When empty the proxy is taken
+	// from the HTTP_PROXY and HTTPS_PROXY environment variables.
+	ProxyURL string
+
+	// NoProxy is the comma separated list of hosts, domains and networks that are accessed
+	// directly. When empty it is taken from the NO_PROXY environment variable.
+	NoProxy string
+
+	// Timeout is the time limit of each request, including reading the response body. Zero means
+	// no limit.
+	Timeout time.Duration
+}
+
+// ProxyFunc returns the function that selects the proxy for each request.
+func (s HttpSettings) ProxyFunc() func(*http.Request) (*url.URL, error) {
+	config := httpproxy.FromEnvironment()
+	if s.ProxyURL != "" {
+		config.HTTPProxy = s.ProxyURL
+		config.HTTPSProxy = s.ProxyURL
+	}
+	if s.NoProxy != "" {
+		config.NoProxy = s.NoProxy
+	}
+	proxyFunc := config.ProxyFunc()
+	return func(request *http.Request) (*url.URL, error) {
+		return proxyFunc(request.URL)
+	}
+}
+
+// TransportWrapper returns a function that applies the proxy and timeout settings to the
+// transports created by the OCM SDK connection.
+func (s HttpSettings) TransportWrapper() func(http.RoundTripper) http.RoundTripper {
+	proxyFunc := s.ProxyFunc()
+	return func(next http.RoundTripper) http.RoundTripper {
+		if transport, ok := next.(*http.Transport); ok {
+			transport.Proxy = proxyFunc
+		}
+		if s.Timeout <= 0 {
+			return next
+		}
+		return &timeoutRoundTripper{
+			next:    next,
+			timeout: s.Timeout,
+		}
+	}
+}
+
+// timeoutRoundTripper cancels the requests that aren't completed, including reading the response
+// body, before the timeout.
+type timeoutRoundTripper struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t *timeoutRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(request.Context(), t.timeout)
+	response, err := t.next.RoundTrip(request.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	response.Body = &cancelOnCloseBody{
+		ReadCloser: response.Body,
+		cancel:     cancel,
+	}
+	return response, nil
+}
+
+// cancelOnCloseBody releases the context of the request when the response body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
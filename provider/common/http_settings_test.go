@@ -0,0 +1,87 @@
+package common
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+)
+
+var _ = Describe("HTTP settings", func() {
+	proxyFor := func(settings HttpSettings, target string) *url.URL {
+		request, err := http.NewRequest(http.MethodGet, target, nil)
+		Expect(err).ToNot(HaveOccurred())
+		proxy, err := settings.ProxyFunc()(request)
+		Expect(err).ToNot(HaveOccurred())
+		return proxy
+	}
+
+	It("Uses the configured proxy", func() {
+		settings := HttpSettings{
+			ProxyURL: "http://proxy.example.com:3128",
+		}
+		proxy := proxyFor(settings, "https://api.openshift.com")
+		Expect(proxy).ToNot(BeNil())
+		Expect(proxy.String()).To(Equal("http://proxy.example.com:3128"))
+	})
+
+	It("Skips the proxy for the excluded hosts", func() {
+		settings := HttpSettings{
+			ProxyURL: "http://proxy.example.com:3128",
+			NoProxy:  "internal.example.com,.corp.example.com",
+		}
+		Expect(proxyFor(settings, "https://internal.example.com")).To(BeNil())
+		Expect(proxyFor(settings, "https://sso.corp.example.com")).To(BeNil())
+		Expect(proxyFor(settings, "https://api.openshift.com")).ToNot(BeNil())
+	})
+
+	It("Sets the proxy of the SDK transports", func() {
+		settings := HttpSettings{
+			ProxyURL: "http://proxy.example.com:3128",
+		}
+		transport := &http.Transport{}
+		Expect(settings.TransportWrapper()(transport)).To(BeIdenticalTo(transport))
+		Expect(transport.Proxy).ToNot(BeNil())
+	})
+
+	It("Cancels the requests that take longer than the timeout", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		}))
+		defer server.Close()
+		settings := HttpSettings{
+			Timeout: 100 * time.Millisecond,
+		}
+		client := &http.Client{
+			Transport: settings.TransportWrapper()(&http.Transport{}),
+		}
+		_, err := client.Get(server.URL)
+		Expect(err).To(MatchError(ContainSubstring("context deadline exceeded")))
+	})
+
+	It("Allows reading the body of the requests completed before the timeout", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("ok"))
+		}))
+		defer server.Close()
+		settings := HttpSettings{
+			Timeout: time.Minute,
+		}
+		client := &http.Client{
+			Transport: settings.TransportWrapper()(&http.Transport{}),
+		}
+		response, err := client.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(Equal("ok"))
+	})
+})
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	iamPolicyVersion = "2012-10-17"
+
+	// IamPathDescription describes the 'path' attribute of the data sources that compute IAM ARNs
+	IamPathDescription = "Path of the IAM roles and policies, for example '/rosa/'. Defaults to '/'."
+)
+
+var iamPathRE = regexp.MustCompile(`^/([\x21-\x7E]*/)?$`)
+
+func IamPathValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(iamPathRE, "path must start and end with '/'"),
+	}
+}
+
+type iamPolicyDocument struct {
+	Version   string               `json:"Version"`
+	Statement []iamPolicyStatement `json:"Statement"`
+}
+
+type iamPolicyStatement struct {
+	Effect    string                         `json:"Effect"`
+	Principal map[string]interface{}         `json:"Principal"`
+	Action    string                         `json:"Action"`
+	Condition map[string]map[string][]string `json:"Condition,omitempty"`
+}
+
+// OidcProviderArn returns the ARN of the IAM OIDC provider of the given OIDC endpoint URL.
+func OidcProviderArn(partition, accountID, oidcEndpointURL string) string {
+	return BuildIamArn(partition, accountID, "oidc-provider", "/", trimOidcEndpointURL(oidcEndpointURL))
+}
+
+// BuildOperatorRoleTrustPolicy returns the trust policy that allows the service accounts of an
+// operator to assume its role through the OIDC provider of the cluster.
+func BuildOperatorRoleTrustPolicy(partition, accountID, oidcEndpointURL string, serviceAccounts []string) (string, error) {
+	document := iamPolicyDocument{
+		Version: iamPolicyVersion,
+		Statement: []iamPolicyStatement{
+			{
+				Effect: "Allow",
+				Principal: map[string]interface{}{
+					"Federated": OidcProviderArn(partition, accountID, oidcEndpointURL),
+				},
+				Action: "sts:AssumeRoleWithWebIdentity",
+				Condition: map[string]map[string][]string{
+					"StringEquals": {
+						fmt.Sprintf("%s:sub", trimOidcEndpointURL(oidcEndpointURL)): serviceAccounts,
+					},
+				},
+			},
+		},
+	}
+	result, err := json.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
+
+func trimOidcEndpointURL(oidcEndpointURL string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(oidcEndpointURL, "https://"), "/")
+}
@@ -0,0 +1,38 @@
+package common
+
+import (
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+)
+
+var _ = Describe("IAM policy helpers", func() {
+	It("Builds the trust policy of an operator role", func() {
+		policy, err := BuildOperatorRoleTrustPolicy(AwsUsGovPartition, "123456789012",
+			"https://oidc.example.com/abc/", []string{"system:serviceaccount:ns:sa"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(policy).To(MatchJSON(`{
+		  "Version": "2012-10-17",
+		  "Statement": [
+		    {
+		      "Effect": "Allow",
+		      "Principal": {
+		        "Federated": "arn:aws-us-gov:iam::123456789012:oidc-provider/oidc.example.com/abc"
+		      },
+		      "Action": "sts:AssumeRoleWithWebIdentity",
+		      "Condition": {
+		        "StringEquals": {
+		          "oidc.example.com/abc:sub": ["system:serviceaccount:ns:sa"]
+		        }
+		      }
+		    }
+		  ]
+		}`))
+	})
+
+	It("Validates IAM paths", func() {
+		Expect(iamPathRE.MatchString("/")).To(BeTrue())
+		Expect(iamPathRE.MatchString("/rosa/team/")).To(BeTrue())
+		Expect(iamPathRE.MatchString("rosa/")).To(BeFalse())
+		Expect(iamPathRE.MatchString("/rosa")).To(BeFalse())
+	})
+})
@@ -39,6 +39,21 @@ func (m *MockClusterWait) EXPECT() *MockClusterWaitMockRecorder {
 	return m.recorder
 }
 
+// WaitForClusterToBeHibernating mocks base method.
+func (m *MockClusterWait) WaitForClusterToBeHibernating(ctx context.Context, clusterId string, waitTimeoutMin int64) (*v1.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForClusterToBeHibernating", ctx, clusterId, waitTimeoutMin)
+	ret0, _ := ret[0].(*v1.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForClusterToBeHibernating indicates an expected call of WaitForClusterToBeHibernating.
+func (mr *MockClusterWaitMockRecorder) WaitForClusterToBeHibernating(ctx, clusterId, waitTimeoutMin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForClusterToBeHibernating", reflect.TypeOf((*MockClusterWait)(nil).WaitForClusterToBeHibernating), ctx, clusterId, waitTimeoutMin)
+}
+
 // WaitForClusterToBeReady mocks base method.
 func (m *MockClusterWait) WaitForClusterToBeReady(ctx context.Context, clusterId string, waitTimeoutMin int64) (*v1.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// GetOidcThumbprint returns the SHA1 hash of the root CA of the issuer URL of the given OIDC config
+// or cluster, as required by the AWS OIDC identity providers. It is calculated by OCM instead of
+// connecting to the issuer, so that it can't be replaced by the certificates of a proxy that
+// intercepts TLS, or by unverified ones when the provider is configured as insecure.
+func GetOidcThumbprint(ctx context.Context, client *cmv1.AWSInquiriesClient,
+	builder *cmv1.OidcThumbprintInputBuilder) (string, error) {
+	input, err := builder.Build()
+	if err != nil {
+		return "", fmt.Errorf("cannot create oidc thumbprint input: %v", err)
+	}
+	thumbprint, err := client.OidcThumbprint().Post().Body(input).SendContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("cannot get thumbprint: %v", err)
+	}
+	if thumbprint.Body() == nil {
+		return "", fmt.Errorf("thumbprint body is empty, thumbprint not retrieved")
+	}
+	return thumbprint.Body().Thumbprint(), nil
+}
+
+// ClusterOidcThumbprintInput returns the input to get the thumbprint of the issuer URL of the given
+// cluster, which is the one of its OIDC config when it has one.
+func ClusterOidcThumbprintInput(cluster *cmv1.Cluster) *cmv1.OidcThumbprintInputBuilder {
+	if oidcConfig, ok := cluster.AWS().STS().GetOidcConfig(); ok && oidcConfig.ID() != "" {
+		return cmv1.NewOidcThumbprintInput().OidcConfigId(oidcConfig.ID())
+	}
+	return cmv1.NewOidcThumbprintInput().ClusterId(cluster.ID())
+}
@@ -0,0 +1,88 @@
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+type fakeInquiriesTransport struct {
+	request *http.Request
+	input   string
+	status  int
+	body    string
+}
+
+func (t *fakeInquiriesTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	t.request = request
+	if request.Body != nil {
+		input, err := io.ReadAll(request.Body)
+		if err != nil {
+			return nil, err
+		}
+		t.input = string(input)
+	}
+	return &http.Response{
+		StatusCode: t.status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(t.body)),
+		Request:    request,
+	}, nil
+}
+
+var _ = Describe("GetOidcThumbprint", func() {
+	const path = "/api/clusters_mgmt/v1/aws_inquiries"
+
+	It("Gets the thumbprint from OCM", func() {
+		transport := &fakeInquiriesTransport{
+			status: http.StatusOK,
+			body:   `{"thumbprint": "9e99a48a9960b14926bb7f3b02e22da2b0ab7280"}`,
+		}
+		thumbprint, err := GetOidcThumbprint(context.Background(), cmv1.NewAWSInquiriesClient(transport, path),
+			cmv1.NewOidcThumbprintInput().OidcConfigId("abc"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(thumbprint).To(Equal("9e99a48a9960b14926bb7f3b02e22da2b0ab7280"))
+		Expect(transport.request.Method).To(Equal(http.MethodPost))
+		Expect(transport.request.URL.Path).To(Equal(path + "/oidc_thumbprint"))
+		Expect(transport.input).To(MatchJSON(`{"oidc_config_id": "abc"}`))
+	})
+
+	It("Fails if OCM can't calculate it", func() {
+		transport := &fakeInquiriesTransport{
+			status: http.StatusBadRequest,
+			body:   `{"kind": "Error", "reason": "Issuer isn't reachable"}`,
+		}
+		_, err := GetOidcThumbprint(context.Background(), cmv1.NewAWSInquiriesClient(transport, path),
+			cmv1.NewOidcThumbprintInput().ClusterId("123"))
+		Expect(err).To(MatchError(ContainSubstring("Issuer isn't reachable")))
+	})
+})
+
+var _ = Describe("ClusterOidcThumbprintInput", func() {
+	It("Uses the OIDC config of the cluster", func() {
+		cluster, err := cmv1.NewCluster().ID("123").AWS(cmv1.NewAWS().STS(
+			cmv1.NewSTS().OidcConfig(cmv1.NewOidcConfig().ID("abc")),
+		)).Build()
+		Expect(err).ToNot(HaveOccurred())
+		input, err := ClusterOidcThumbprintInput(cluster).Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(input.OidcConfigId()).To(Equal("abc"))
+		Expect(input.ClusterId()).To(BeEmpty())
+	})
+
+	It("Uses the cluster when it has no OIDC config", func() {
+		cluster, err := cmv1.NewCluster().ID("123").AWS(cmv1.NewAWS().STS(
+			cmv1.NewSTS().OIDCEndpointURL("https://oidc.example.com/123"),
+		)).Build()
+		Expect(err).ToNot(HaveOccurred())
+		input, err := ClusterOidcThumbprintInput(cluster).Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(input.ClusterId()).To(Equal("123"))
+		Expect(input.OidcConfigId()).To(BeEmpty())
+	})
+})
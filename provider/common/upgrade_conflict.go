@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// UpgradeConflictPolicyCancel cancels the upgrades scheduled outside of Terraform that don't match
+	// the requested version. This is the default.
+	UpgradeConflictPolicyCancel = "cancel"
+	// UpgradeConflictPolicyFail fails the apply if there are upgrades scheduled outside of Terraform
+	// that don't match the requested version.
+	UpgradeConflictPolicyFail = "fail"
+	// UpgradeConflictPolicyKeep keeps the upgrades scheduled outside of Terraform, and fails the
+	// apply if a new upgrade can't be scheduled while they exist.
+	UpgradeConflictPolicyKeep = "keep"
+)
+
+var UpgradeConflictPolicies = []string{
+	UpgradeConflictPolicyCancel,
+	UpgradeConflictPolicyFail,
+	UpgradeConflictPolicyKeep,
+}
+
+const UpgradeConflictPolicyDescription = "Determines what to do with the pending or scheduled upgrades " +
+	"that don't match the requested version, for example upgrades scheduled with the OCM console or " +
+	"the `rosa` CLI. Valid values are `cancel`, which cancels them, `fail`, which fails the apply, and " +
+	"`keep`, which keeps them and fails the apply if the requested upgrade can't be scheduled while they " +
+	"exist, so that it is requested again by the next apply. The default is `cancel`."
+
+// UpgradeConflictPolicy returns the upgrade conflict policy to apply, or the default one if it isn't set.
+func UpgradeConflictPolicy(value string) string {
+	if value == "" {
+		return UpgradeConflictPolicyCancel
+	}
+	return value
+}
+
+// DescribeUpgradeConflict returns a human readable description of an upgrade that conflicts with the
+// requested version.
+func DescribeUpgradeConflict(id, version string, nextRun time.Time) string {
+	return fmt.Sprintf("upgrade policy '%s' to version '%s' scheduled for %s",
+		id, version, nextRun.UTC().Format(time.RFC3339))
+}
+
+// UpgradeConflictWarning returns the summary and details of the plan warning for the upgrades that
+// conflict with the requested version.
+func UpgradeConflictWarning(policy string, conflicts []string, desiredVersion string) (string, string) {
+	var outcome string
+	switch UpgradeConflictPolicy(policy) {
+	case UpgradeConflictPolicyFail:
+		outcome = "the apply will fail"
+	case UpgradeConflictPolicyKeep:
+		outcome = fmt.Sprintf("they will be kept, and the apply will fail because the upgrade to "+
+			"version '%s' can't be scheduled while they exist", desiredVersion)
+	default:
+		outcome = "they will be cancelled"
+	}
+	details := fmt.Sprintf("The following upgrades don't match the requested version '%s', and %s "+
+		"according to the 'upgrade_conflict_policy' attribute:", desiredVersion, outcome)
+	for _, conflict := range conflicts {
+		details = fmt.Sprintf("%s\n  - %s", details, conflict)
+	}
+	return "Conflicting upgrades", details
+}
+
+// KeptUpgradeConflictsError returns the error for the upgrade to the requested version that can't
+// be scheduled because the conflicting upgrades were kept.
+func KeptUpgradeConflictsError(conflicts []string, desiredVersion string) error {
+	return fmt.Errorf("the upgrade to version '%s' can't be scheduled while the following upgrades "+
+		"are kept according to the 'upgrade_conflict_policy' attribute: %s. Apply again once they "+
+		"have completed or been cancelled", desiredVersion, strings.Join(conflicts, ", "))
+}
+
+// PlannedUpgradeVersion returns the version that the plan requests to upgrade to. It returns false if
+// the requested version doesn't change, or if it is below the current version.
+func PlannedUpgradeVersion(ctx context.Context, req resource.ModifyPlanRequest) (*version.Version, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return nil, false, diags
+	}
+	var stateVersion, currentVersion, planVersion types.String
+	diags.Append(req.State.GetAttribute(ctx, path.Root("version"), &stateVersion)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("current_version"), &currentVersion)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("version"), &planVersion)...)
+	if diags.HasError() {
+		return nil, false, diags
+	}
+	if IsStringAttributeUnknownOrEmpty(planVersion) || IsStringAttributeUnknownOrEmpty(currentVersion) ||
+		planVersion.Equal(stateVersion) {
+		return nil, false, diags
+	}
+	current, err := version.NewVersion(strings.TrimPrefix(currentVersion.ValueString(), versionPrefix))
+	if err != nil {
+		return nil, false, diags
+	}
+	desired, err := version.NewVersion(strings.TrimPrefix(planVersion.ValueString(), versionPrefix))
+	if err != nil || current.GreaterThan(desired) {
+		return nil, false, diags
+	}
+	return desired, true, diags
+}
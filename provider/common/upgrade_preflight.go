@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const (
+	UpgradePreflightChecksDescription = "Check the health of the cluster before scheduling an upgrade: the " +
+		"cluster state, the limited support reasons, the cluster operators, the machine pools of " +
+		"hosted control plane clusters and the upgrades in progress. The upgrade isn't scheduled if " +
+		"any check fails. Defaults to false."
+	ForceUpgradeDescription = "Skip the upgrade preflight checks enabled by 'upgrade_preflight_checks'. " +
+		"Defaults to false."
+)
+
+// UpgradePreflight checks the health of a cluster before scheduling an upgrade.
+type UpgradePreflight struct {
+	collection *cmv1.ClustersClient
+}
+
+func NewUpgradePreflight(collection *cmv1.ClustersClient) *UpgradePreflight {
+	return &UpgradePreflight{collection: collection}
+}
+
+// Check runs the preflight checks and returns an error describing all the checks that failed.
+// The given in-flight upgrades are descriptions of the upgrades of the cluster that are in progress.
+func (p *UpgradePreflight) Check(ctx context.Context, clusterID string, inFlightUpgrades []string) error {
+	tflog.Debug(ctx, fmt.Sprintf("Running upgrade preflight checks for cluster '%s'", clusterID))
+	clusterClient := p.collection.Cluster(clusterID)
+	get, err := clusterClient.Get().SendContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get cluster '%s': %v", clusterID, HandleErr(get.Error(), err))
+	}
+	cluster := get.Body()
+
+	failures := []string{}
+	if cluster.State() != cmv1.ClusterStateReady {
+		failures = append(failures, fmt.Sprintf("cluster is in state '%s', expected '%s'",
+			cluster.State(), cmv1.ClusterStateReady))
+	}
+
+	reasons, err := p.limitedSupportReasons(ctx, clusterClient)
+	if err != nil {
+		return err
+	}
+	failures = append(failures, reasons...)
+
+	operators, err := p.unhealthyOperators(ctx, clusterClient)
+	if err != nil {
+		return err
+	}
+	failures = append(failures, operators...)
+
+	if cluster.Hypershift().Enabled() {
+		pools, err := p.scalingNodePools(ctx, clusterClient)
+		if err != nil {
+			return err
+		}
+		failures = append(failures, pools...)
+	}
+
+	for _, upgrade := range inFlightUpgrades {
+		failures = append(failures, fmt.Sprintf("upgrade in progress: %s", upgrade))
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("upgrade preflight checks failed for cluster '%s':\n  - %s\n"+
+		"Fix the issues, or set 'force_upgrade = true' to schedule the upgrade anyway",
+		clusterID, strings.Join(failures, "\n  - "))
+}
+
+func (p *UpgradePreflight) limitedSupportReasons(ctx context.Context, clusterClient *cmv1.ClusterClient) ([]string, error) {
+	failures := []string{}
+	list, err := clusterClient.LimitedSupportReasons().List().SendContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get limited support reasons: %v", HandleErr(list.Error(), err))
+	}
+	list.Items().Each(func(reason *cmv1.LimitedSupportReason) bool {
+		failures = append(failures, fmt.Sprintf("cluster is in limited support: %s: %s",
+			reason.Summary(), reason.Details()))
+		return true
+	})
+	return failures, nil
+}
+
+func (p *UpgradePreflight) unhealthyOperators(ctx context.Context, clusterClient *cmv1.ClusterClient) ([]string, error) {
+	failures := []string{}
+	get, err := clusterClient.MetricQueries().ClusterOperators().Get().SendContext(ctx)
+	if err != nil {
+		// The metrics aren't available for all the clusters, don't block the upgrade in that case
+		tflog.Warn(ctx, fmt.Sprintf("Can't check the cluster operators: %v", err))
+		return failures, nil
+	}
+	for _, operator := range get.Body().Operators() {
+		switch operator.Condition() {
+		case cmv1.ClusterOperatorStateDegraded, cmv1.ClusterOperatorStateFailing:
+			failures = append(failures, fmt.Sprintf("cluster operator '%s' is %s: %s",
+				operator.Name(), operator.Condition(), operator.Reason()))
+		}
+	}
+	return failures, nil
+}
+
+func (p *UpgradePreflight) scalingNodePools(ctx context.Context, clusterClient *cmv1.ClusterClient) ([]string, error) {
+	failures := []string{}
+	page := 1
+	size := 100
+	for {
+		list, err := clusterClient.NodePools().List().
+			Page(page).
+			Size(size).
+			SendContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get machine pools: %v", HandleErr(list.Error(), err))
+		}
+		list.Items().Each(func(nodePool *cmv1.NodePool) bool {
+			status, ok := nodePool.GetStatus()
+			if !ok {
+				return true
+			}
+			current := status.CurrentReplicas()
+			scaling := current != nodePool.Replicas()
+			if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+				scaling = current < autoscaling.MinReplica() || current > autoscaling.MaxReplica()
+			}
+			if scaling {
+				failures = append(failures, fmt.Sprintf("machine pool '%s' is scaling, it has %d replicas: %s",
+					nodePool.ID(), current, status.Message()))
+			}
+			return true
+		})
+		if list.Size() < size {
+			break
+		}
+		page++
+	}
+	return failures, nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// The polling interval and the unit of the wait timeout are variables so that the tests can make
+// them shorter.
+var (
+	upgradePollingInterval = time.Minute
+	upgradeWaitTimeoutUnit = time.Minute
+)
+
+// UpgradeStateFunc returns the current state of an upgrade policy, or nil if the upgrade policy
+// doesn't exist any more, which happens once the upgrade is completed.
+type UpgradeStateFunc func(ctx context.Context) (*cmv1.UpgradePolicyState, error)
+
+// WaitForUpgradeToComplete polls the state of the given upgrade policy until the upgrade is completed
+// or failed, reporting the progress to the log. It returns an error with the description of the
+// state if the upgrade fails, or if it doesn't complete within the timeout.
+func WaitForUpgradeToComplete(ctx context.Context, policyID string, waitTimeoutMin int64,
+	getState UpgradeStateFunc) error {
+	tflog.Info(ctx, fmt.Sprintf("WaitForUpgradeToComplete: Waiting for upgrade policy '%s' to complete "+
+		"with timeout %d minutes", policyID, waitTimeoutMin))
+	pollCtx, cancel := context.WithTimeout(ctx, time.Duration(waitTimeoutMin)*upgradeWaitTimeoutUnit)
+	defer cancel()
+
+	var lastState cmv1.UpgradePolicyStateValue
+	for {
+		state, err := getState(pollCtx)
+		if err != nil {
+			if ctx.Err() == nil && pollCtx.Err() != nil {
+				// The timeout expired while getting the state
+				return upgradeTimeoutError(policyID, waitTimeoutMin, lastState)
+			}
+			return fmt.Errorf("failed to get the state of upgrade policy '%s': %v", policyID, err)
+		}
+		if state == nil {
+			tflog.Info(ctx, fmt.Sprintf("WaitForUpgradeToComplete: Upgrade policy '%s' no longer exists, "+
+				"the upgrade is completed", policyID))
+			return nil
+		}
+		switch state.Value() {
+		case cmv1.UpgradePolicyStateValueCompleted:
+			tflog.Info(ctx, fmt.Sprintf("WaitForUpgradeToComplete: Upgrade policy '%s' is completed", policyID))
+			return nil
+		case cmv1.UpgradePolicyStateValueFailed:
+			return fmt.Errorf("upgrade policy '%s' failed: %s", policyID, state.Description())
+		}
+		if state.Value() != lastState {
+			tflog.Info(ctx, fmt.Sprintf("WaitForUpgradeToComplete: Upgrade policy '%s' is in state '%s': %s",
+				policyID, state.Value(), state.Description()))
+			lastState = state.Value()
+		} else {
+			tflog.Debug(ctx, "polled upgrade policy state", map[string]interface{}{
+				"id":    policyID,
+				"state": state.Value(),
+			})
+		}
+
+		select {
+		case <-pollCtx.Done():
+			return upgradeTimeoutError(policyID, waitTimeoutMin, state.Value())
+		case <-time.After(upgradePollingInterval):
+		}
+	}
+}
+
+// upgradeTimeoutError returns the error reported when the upgrade doesn't complete in time, with
+// the last known state of the upgrade policy, if any.
+func upgradeTimeoutError(policyID string, waitTimeoutMin int64, state cmv1.UpgradePolicyStateValue) error {
+	if state == "" {
+		return fmt.Errorf("upgrade policy '%s' didn't complete within %d minutes", policyID, waitTimeoutMin)
+	}
+	return fmt.Errorf("upgrade policy '%s' didn't complete within %d minutes, it is in state '%s'",
+		policyID, waitTimeoutMin, state)
+}
@@ -0,0 +1,59 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("WaitForUpgradeToComplete", func() {
+	BeforeEach(func() {
+		DeferCleanup(func(interval, unit time.Duration) {
+			upgradePollingInterval = interval
+			upgradeWaitTimeoutUnit = unit
+		}, upgradePollingInterval, upgradeWaitTimeoutUnit)
+		upgradePollingInterval = 10 * time.Millisecond
+		upgradeWaitTimeoutUnit = 100 * time.Millisecond
+	})
+
+	// stateValues returns a function that returns the upgrade policy states with the given values, and
+	// then blocks until the context is done:
+	stateValues := func(values ...cmv1.UpgradePolicyStateValue) UpgradeStateFunc {
+		return func(ctx context.Context) (*cmv1.UpgradePolicyState, error) {
+			if len(values) == 0 {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			value := values[0]
+			values = values[1:]
+			return cmv1.NewUpgradePolicyState().Value(value).Description("Upgrade " + string(value)).Build()
+		}
+	}
+
+	It("Succeeds when the upgrade completes", func() {
+		err := WaitForUpgradeToComplete(context.Background(), "123", 1, stateValues(
+			cmv1.UpgradePolicyStateValueScheduled,
+			cmv1.UpgradePolicyStateValueStarted,
+			cmv1.UpgradePolicyStateValueCompleted,
+		))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Fails when the upgrade fails", func() {
+		err := WaitForUpgradeToComplete(context.Background(), "123", 1, stateValues(
+			cmv1.UpgradePolicyStateValueStarted,
+			cmv1.UpgradePolicyStateValueFailed,
+		))
+		Expect(err).To(MatchError("upgrade policy '123' failed: Upgrade failed"))
+	})
+
+	It("Reports the timeout when it expires while getting the state", func() {
+		err := WaitForUpgradeToComplete(context.Background(), "123", 1, stateValues(
+			cmv1.UpgradePolicyStateValueStarted,
+		))
+		Expect(err).To(MatchError("upgrade policy '123' didn't complete within 1 minutes, it is in state 'started'"))
+	})
+})
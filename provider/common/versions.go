@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// versionCache keeps the versions retrieved from the server, so that they are fetched only once
+// during the provider run.
+type versionCache struct {
+	lock  sync.Mutex
+	store map[string]*cmv1.Version
+}
+
+var versions = &versionCache{
+	store: make(map[string]*cmv1.Version),
+}
+
+func (c *versionCache) get(id string) (*cmv1.Version, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	version, ok := c.store[id]
+	return version, ok
+}
+
+func (c *versionCache) add(version *cmv1.Version) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.store[version.ID()] = version
+}
+
+// GetVersions returns the versions with the given identifiers, in the same order. The versions that
+// haven't been retrieved yet during the provider run are fetched with a single paginated search.
+func GetVersions(ctx context.Context, client *cmv1.VersionsClient, ids []string) ([]*cmv1.Version, error) {
+	missing := []string{}
+	for _, id := range ids {
+		if _, ok := versions.get(id); !ok {
+			missing = append(missing, fmt.Sprintf("'%s'", id))
+		}
+	}
+	if len(missing) > 0 {
+		search := fmt.Sprintf("id in (%s)", strings.Join(missing, ", "))
+		page := 1
+		size := 100
+		for {
+			resp, err := client.List().
+				Search(search).
+				Page(page).
+				Size(size).
+				SendContext(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get version information: %v", err)
+			}
+			for _, version := range resp.Items().Slice() {
+				versions.add(version)
+			}
+			if resp.Size() < size {
+				break
+			}
+			page++
+		}
+	}
+
+	result := make([]*cmv1.Version, 0, len(ids))
+	for _, id := range ids {
+		version, ok := versions.get(id)
+		if !ok {
+			return nil, fmt.Errorf("failed to get version information: version '%s' not found", id)
+		}
+		result = append(result, version)
+	}
+	return result, nil
+}
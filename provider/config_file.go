@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ocmConfig is the configuration written by the 'ocm login' and 'rosa login' commands, usually
+// in the '~/.config/ocm/ocm.json' file.
+type ocmConfig struct {
+	URL          string   `json:"url,omitempty"`
+	TokenURL     string   `json:"token_url,omitempty"`
+	ClientID     string   `json:"client_id,omitempty"`
+	ClientSecret string   `json:"client_secret,omitempty"`
+	AccessToken  string   `json:"access_token,omitempty"`
+	RefreshToken string   `json:"refresh_token,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+	Insecure     bool     `json:"insecure,omitempty"`
+	FedRAMP      bool     `json:"fedramp,omitempty"`
+}
+
+// ocmConfigFile is the content of the configuration file. The top level settings are the ones
+// written by the CLI tools, and the 'profiles' object contains additional named configurations.
+type ocmConfigFile struct {
+	ocmConfig
+	Profiles map[string]ocmConfig `json:"profiles,omitempty"`
+}
+
+// Tokens returns the tokens of the configuration, the access token first.
+func (c *ocmConfig) Tokens() []string {
+	var tokens []string
+	if c.AccessToken != "" {
+		tokens = append(tokens, c.AccessToken)
+	}
+	if c.RefreshToken != "" {
+		tokens = append(tokens, c.RefreshToken)
+	}
+	return tokens
+}
+
+// defaultConfigFile returns the location of the configuration file used by the CLI tools: the
+// value of the 'OCM_CONFIG' environment variable, or the legacy '~/.ocm.json' file if it exists,
+// or otherwise 'ocm/ocm.json' in the configuration directory of the user.
+func defaultConfigFile() (string, error) {
+	if path := os.Getenv("OCM_CONFIG"); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	legacyPath := filepath.Join(home, ".ocm.json")
+	if _, err := os.Stat(legacyPath); err == nil {
+		return legacyPath, nil
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "ocm", "ocm.json"), nil
+}
+
+// expandHome replaces the '~' at the beginning of the path with the home directory of the user.
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
+
+// loadOcmConfig reads the configuration file and returns the top level settings, or the ones of
+// the given profile if it isn't empty. When the path is empty the default location of the file
+// is used.
+func loadOcmConfig(path string, profile string) (*ocmConfig, error) {
+	var err error
+	if path == "" {
+		path, err = defaultConfigFile()
+	} else {
+		path, err = expandHome(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("can't read configuration file '%s': %v", path, err)
+	}
+	var file ocmConfigFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("can't parse configuration file '%s': %v", path, err)
+	}
+	if profile == "" {
+		return &file.ocmConfig, nil
+	}
+	config, ok := file.Profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("configuration file '%s' doesn't contain profile '%s'", path, profile)
+	}
+	return &config, nil
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+	sdk "github.com/openshift-online/ocm-sdk-go"
+)
+
+var _ = Describe("Configuration file", func() {
+	var dir string
+
+	writeConfig := func(name string, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+		return path
+	}
+
+	setEnv := func(name string, value string) {
+		previous, exists := os.LookupEnv(name)
+		Expect(os.Setenv(name, value)).To(Succeed())
+		DeferCleanup(func() {
+			if exists {
+				os.Setenv(name, previous)
+			} else {
+				os.Unsetenv(name)
+			}
+		})
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	It("Loads the settings written by the CLI tools", func() {
+		path := writeConfig("ocm.json", `{
+		  "access_token": "my-access-token",
+		  "client_id": "cloud-services",
+		  "refresh_token": "my-refresh-token",
+		  "scopes": ["openid"],
+		  "token_url": "https://sso.redhat.com/auth/realms/redhat-external/protocol/openid-connect/token",
+		  "url": "https://api.stage.openshift.com",
+		  "pager": "less"
+		}`)
+		config, err := loadOcmConfig(path, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.URL).To(Equal("https://api.stage.openshift.com"))
+		Expect(config.TokenURL).To(Equal(sdk.DefaultTokenURL))
+		Expect(config.ClientID).To(Equal("cloud-services"))
+		Expect(config.ClientSecret).To(BeEmpty())
+		Expect(config.Scopes).To(ConsistOf("openid"))
+		Expect(config.Insecure).To(BeFalse())
+		Expect(config.FedRAMP).To(BeFalse())
+		Expect(config.Tokens()).To(Equal([]string{"my-access-token", "my-refresh-token"}))
+	})
+
+	It("Loads the settings of a profile", func() {
+		path := writeConfig("ocm.json", `{
+		  "url": "https://api.openshift.com",
+		  "refresh_token": "my-production-token",
+		  "profiles": {
+		    "gov": {
+		      "url": "https://api.openshiftusgov.com",
+		      "refresh_token": "my-gov-token",
+		      "fedramp": true
+		    }
+		  }
+		}`)
+		config, err := loadOcmConfig(path, "gov")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.URL).To(Equal("https://api.openshiftusgov.com"))
+		Expect(config.FedRAMP).To(BeTrue())
+		Expect(config.Tokens()).To(Equal([]string{"my-gov-token"}))
+	})
+
+	It("Fails if the profile doesn't exist", func() {
+		path := writeConfig("ocm.json", `{
+		  "url": "https://api.openshift.com"
+		}`)
+		_, err := loadOcmConfig(path, "staging")
+		Expect(err).To(MatchError(ContainSubstring("doesn't contain profile 'staging'")))
+	})
+
+	It("Fails if the file doesn't exist", func() {
+		_, err := loadOcmConfig(filepath.Join(dir, "missing.json"), "")
+		Expect(err).To(MatchError(ContainSubstring("can't read configuration file")))
+	})
+
+	It("Fails if the file isn't valid JSON", func() {
+		path := writeConfig("ocm.json", `url: https://api.openshift.com`)
+		_, err := loadOcmConfig(path, "")
+		Expect(err).To(MatchError(ContainSubstring("can't parse configuration file")))
+	})
+
+	It("Uses the 'OCM_CONFIG' environment variable by default", func() {
+		path := writeConfig("other.json", `{
+		  "url": "https://api.integration.openshift.com"
+		}`)
+		setEnv("OCM_CONFIG", path)
+		config, err := loadOcmConfig("", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.URL).To(Equal("https://api.integration.openshift.com"))
+	})
+
+	It("Expands the home directory", func() {
+		setEnv("HOME", dir)
+		writeConfig("ocm.json", `{
+		  "url": "https://api.openshift.com"
+		}`)
+		config, err := loadOcmConfig("~/ocm.json", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.URL).To(Equal("https://api.openshift.com"))
+	})
+})
+
+var _ = Describe("URL aliases", func() {
+	It("Resolves the commercial environments", func() {
+		Expect(resolveURLAlias("staging", urlAliases)).To(Equal("https://api.stage.openshift.com"))
+		Expect(resolveURLAlias("Integration", urlAliases)).To(Equal("https://api.integration.openshift.com"))
+		Expect(resolveURLAlias("production", urlAliases)).To(Equal(sdk.DefaultURL))
+		Expect(resolveURLAlias("staging", tokenURLAliases)).To(Equal(sdk.DefaultTokenURL))
+	})
+
+	It("Resolves the FedRAMP environments", func() {
+		Expect(resolveURLAlias("staging", fedRAMPURLAliases)).To(Equal("https://api.stage.openshiftusgov.com"))
+	})
+
+	It("Keeps the URLs that aren't aliases", func() {
+		Expect(resolveURLAlias("https://api.example.com", urlAliases)).To(Equal("https://api.example.com"))
+	})
+})
@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+)
+
+// The environments of OCM, that can be used as aliases of their URLs in the 'url' and 'token_url'
+// attributes.
+const (
+	environmentProduction  = "production"
+	environmentStaging     = "staging"
+	environmentIntegration = "integration"
+)
+
+var urlAliases = map[string]string{
+	environmentProduction:  sdk.DefaultURL,
+	environmentStaging:     "https://api.stage.openshift.com",
+	environmentIntegration: "https://api.integration.openshift.com",
+}
+
+// All the commercial environments use the same SSO server.
+var tokenURLAliases = map[string]string{
+	environmentProduction:  sdk.DefaultTokenURL,
+	environmentStaging:     sdk.DefaultTokenURL,
+	environmentIntegration: sdk.DefaultTokenURL,
+}
+
+// resolveURLAlias returns the URL of the environment when the value is one of the aliases,
+// otherwise the value itself.
+func resolveURLAlias(value string, aliases map[string]string) string {
+	if url, ok := aliases[strings.ToLower(value)]; ok {
+		return url
+	}
+	return value
+}
+
+func urlAliasesDescription(aliases map[string]string) string {
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, fmt.Sprintf("'%s'", name))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
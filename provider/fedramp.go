@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+// The URLs of the FedRAMP environments of OCM, used when 'fedramp' is enabled.
+var fedRAMPURLAliases = map[string]string{
+	environmentProduction:  "https://api.openshiftusgov.com",
+	environmentStaging:     "https://api.stage.openshiftusgov.com",
+	environmentIntegration: "https://api.int.openshiftusgov.com",
+}
+
+var fedRAMPTokenURLAliases = map[string]string{
+	environmentProduction:  "https://sso.openshiftusgov.com/realms/redhat-external/protocol/openid-connect/token",
+	environmentStaging:     "https://sso.stage.openshiftusgov.com/realms/redhat-external/protocol/openid-connect/token",
+	environmentIntegration: "https://sso.int.openshiftusgov.com/realms/redhat-external/protocol/openid-connect/token",
+}
@@ -0,0 +1,345 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type MachinePoolsDatasource struct {
+	collection *cmv1.ClustersClient
+}
+
+var _ datasource.DataSource = &MachinePoolsDatasource{}
+var _ datasource.DataSourceWithConfigure = &MachinePoolsDatasource{}
+
+func NewMachinePoolsDatasource() datasource.DataSource {
+	return &MachinePoolsDatasource{}
+}
+
+func (r *MachinePoolsDatasource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_pools"
+}
+
+func (r *MachinePoolsDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*sdk.Connection)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.collection = connection.ClustersMgmt().V1().Clusters()
+}
+
+func (r *MachinePoolsDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of the machine pools of a cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster of the machine pools.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Only return the machine pools that have all these labels.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"machine_type": schema.StringAttribute{
+				Description: "Only return the machine pools that use this machine type, for example `m5.xlarge`.",
+				Optional:    true,
+			},
+			"availability_zone": schema.StringAttribute{
+				Description: "Only return the machine pools that have machines in this availability zone.",
+				Optional:    true,
+			},
+			"autoscaling_enabled": schema.BoolAttribute{
+				Description: "Only return the machine pools with auto-scaling activated (`true`) or deactivated (`false`).",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Machine pools of the cluster matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: r.itemAttributes(),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *MachinePoolsDatasource) itemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique identifier of the machine pool.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the machine pool",
+			Computed:    true,
+		},
+		"machine_type": schema.StringAttribute{
+			Description: "Identifier of the machine type used by the nodes, for example `m5.xlarge`. ",
+			Computed:    true,
+		},
+		"replicas": schema.Int64Attribute{
+			Description: "The machines number in the machine pool. relevant only in case of 'autoscaling_enabled = false'",
+			Computed:    true,
+		},
+		"use_spot_instances": schema.BoolAttribute{
+			Description: "Indicates if Amazon EC2 Spot Instances used in this machine pool.",
+			Computed:    true,
+		},
+		"max_spot_price": schema.Float64Attribute{
+			Description: "Max Spot price.",
+			Computed:    true,
+		},
+		"autoscaling_enabled": schema.BoolAttribute{
+			Description: "Specifies whether auto-scaling is activated for this machine pool.",
+			Computed:    true,
+		},
+		"min_replicas": schema.Int64Attribute{
+			Description: "The minimum number of replicas for autos-caling functionality. relevant only in case of 'autoscaling_enabled = true",
+			Computed:    true,
+		},
+		"max_replicas": schema.Int64Attribute{
+			Description: "The maximum number of replicas for auto-scaling functionality. relevant only in case of 'autoscaling_enabled = true'",
+			Computed:    true,
+		},
+		"taints": schema.ListNestedAttribute{
+			Description: "The list of the Taints of this machine pool.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Description: "Taints key",
+						Computed:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "Taints value",
+						Computed:    true,
+					},
+					"schedule_type": schema.StringAttribute{
+						Description: "Taints schedule type",
+						Computed:    true,
+					},
+				},
+			},
+			Computed: true,
+		},
+		"labels": schema.MapAttribute{
+			Description: "The list of the Labels of this machine pool.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"multi_availability_zone": schema.BoolAttribute{
+			Description: "Specifies whether this machine pool is a multi-AZ machine pool. Relevant only in case of multi-AZ cluster",
+			Computed:    true,
+		},
+		"availability_zone": schema.StringAttribute{
+			Description: "A single availability zone in which the machines of this machine pool are created. Relevant only for a single availability zone machine pool. For multiple availability zones check \"availability_zones\" attribute",
+			Computed:    true,
+		},
+		"availability_zones": schema.ListAttribute{
+			Description: "A list of Availability Zones. Relevant only for multiple availability zones machine pool. For single availability zone check \"availability_zone\" attribute.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"subnet_id": schema.StringAttribute{
+			Description: "An ID of single subnet in which the machines of this machine pool are created. Relevant only for a machine pool with single subnet. For machine pool with multiple subnets check \"subnet_ids\" attribute",
+			Computed:    true,
+		},
+		"subnet_ids": schema.ListAttribute{
+			Description: "A list of IDs of subnets in which the machines of this machine pool are created. Relevant only for a machine pool with multiple subnets. For machine pool with single subnet check \"subnet_id\" attribute",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"disk_size": schema.Int64Attribute{
+			Description: "The root disk size, in GiB.",
+			Computed:    true,
+		},
+	}
+}
+
+func (r *MachinePoolsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the current state:
+	state := &MachinePoolsState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterObject := fetchCluster(ctx, &MachinePoolState{Cluster: state.Cluster}, r.collection, &resp.Diagnostics)
+	if clusterObject == nil {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Failed to find cluster",
+				fmt.Sprintf("Failed to find cluster with identifier %s.", state.Cluster.ValueString()),
+			)
+		}
+		return
+	}
+
+	labels, err := common.OptionalMap(ctx, state.Labels)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't list machine pools",
+			fmt.Sprintf("Failed to read the labels filter: %v", err),
+		)
+		return
+	}
+
+	pools, err := listMachinePools(ctx, r.collection, state.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't list machine pools",
+			fmt.Sprintf(
+				"Failed to list machine pools for cluster %s: %v",
+				state.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+
+	state.Items = []*MachinePoolsItem{}
+	for _, pool := range pools {
+		if !matchesMachinePoolFilters(pool, state, labels) {
+			continue
+		}
+		item, diags := machinePoolToItem(ctx, pool, state.Cluster, clusterObject)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Items = append(state.Items, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// listMachinePools returns all the machine pools of the given cluster
+func listMachinePools(ctx context.Context, collection *cmv1.ClustersClient, clusterID string) ([]*cmv1.MachinePool, error) {
+	pools := []*cmv1.MachinePool{}
+	poolsClient := collection.Cluster(clusterID).MachinePools()
+	page := 1
+	size := 100
+	for {
+		resp, err := poolsClient.List().
+			Page(page).
+			Size(size).
+			SendContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, resp.Items().Slice()...)
+		if resp.Size() < size {
+			break
+		}
+		page++
+	}
+	return pools, nil
+}
+
+func matchesMachinePoolFilters(pool *cmv1.MachinePool, state *MachinePoolsState, labels map[string]string) bool {
+	if !common.ContainsAllLabels(pool.Labels(), labels) {
+		return false
+	}
+	if common.HasValue(state.MachineType) && pool.InstanceType() != state.MachineType.ValueString() {
+		return false
+	}
+	if common.HasValue(state.AvailabilityZone) {
+		found := false
+		for _, az := range pool.AvailabilityZones() {
+			if az == state.AvailabilityZone.ValueString() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if common.HasValue(state.AutoScalingEnabled) {
+		_, autoscalingEnabled := pool.GetAutoscaling()
+		if autoscalingEnabled != state.AutoScalingEnabled.ValueBool() {
+			return false
+		}
+	}
+	return true
+}
+
+func machinePoolToItem(ctx context.Context, pool *cmv1.MachinePool, cluster types.String,
+	clusterObject *cmv1.Cluster) (*MachinePoolsItem, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	poolState := &MachinePoolState{
+		Cluster: cluster,
+		ID:      types.StringValue(pool.ID()),
+	}
+	if err := populateState(ctx, pool, poolState, clusterObject); err != nil {
+		diags.AddError(
+			"Can't populate machine pool state",
+			fmt.Sprintf(
+				"Received error %v", err,
+			),
+		)
+		return nil, diags
+	}
+	if !common.HasValue(poolState.AutoScalingEnabled) {
+		poolState.AutoScalingEnabled = types.BoolValue(false)
+	}
+	return &MachinePoolsItem{
+		ID:                    poolState.ID,
+		Name:                  poolState.Name,
+		MachineType:           poolState.MachineType,
+		Replicas:              poolState.Replicas,
+		UseSpotInstances:      poolState.UseSpotInstances,
+		MaxSpotPrice:          poolState.MaxSpotPrice,
+		AutoScalingEnabled:    poolState.AutoScalingEnabled,
+		MinReplicas:           poolState.MinReplicas,
+		MaxReplicas:           poolState.MaxReplicas,
+		Taints:                poolState.Taints,
+		Labels:                poolState.Labels,
+		MultiAvailabilityZone: poolState.MultiAvailabilityZone,
+		AvailabilityZone:      poolState.AvailabilityZone,
+		AvailabilityZones:     poolState.AvailabilityZones,
+		SubnetID:              poolState.SubnetID,
+		SubnetIDs:             poolState.SubnetIDs,
+		DiskSize:              poolState.DiskSize,
+	}, diags
+}
@@ -158,6 +158,15 @@ func (r *HcpMachinePoolDatasource) Schema(ctx context.Context, req datasource.Sc
 				Optional:    true,
 				Computed:    true,
 			},
+			"management_upgrade": schema.SingleNestedAttribute{
+				Description: "Settings used to replace or update the nodes of the machine pool during upgrades.",
+				Attributes:  ManagementUpgradeDatasource(),
+				Computed:    true,
+			},
+			"node_drain_grace_period": schema.Int64Attribute{
+				Description: "Time, in minutes, that the nodes are given to drain their pods before being forcibly removed.",
+				Computed:    true,
+			},
 			"version": schema.StringAttribute{
 				Description: "Desired version of OpenShift for the machine pool, for example '4.11.0'. If version is greater than the currently running version, an upgrade will be scheduled.",
 				Optional:    true,
@@ -166,12 +175,32 @@ func (r *HcpMachinePoolDatasource) Schema(ctx context.Context, req datasource.Sc
 				Description: "The currently running version of OpenShift on the machine pool, for example '4.11.0'.",
 				Computed:    true,
 			},
+			"control_plane_version": schema.StringAttribute{
+				Description: "The version of OpenShift running on the control plane of the cluster, for example '4.11.0'.",
+				Computed:    true,
+			},
+			"machine_pool_version_policy": schema.StringAttribute{
+				Description: "Policy used to decide the version of the machine pool.",
+				Computed:    true,
+			},
 			"upgrade_acknowledgements_for": schema.StringAttribute{
 				Description: "Indicates acknowledgement of agreements required to upgrade the cluster version between" +
 					" minor versions (e.g. a value of \"4.12\" indicates acknowledgement of any agreements required to " +
 					"upgrade to OpenShift 4.12.z from 4.11 or before).",
 				Computed: true,
 			},
+			"upgrade_conflict_policy": schema.StringAttribute{
+				Description: common.UpgradeConflictPolicyDescription,
+				Computed:    true,
+			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Description: "Indicates whether the provider waits for the upgrades to complete.",
+				Computed:    true,
+			},
+			"max_upgrade_wait_timeout_in_minutes": schema.Int64Attribute{
+				Description: "This value sets the maximum duration in minutes to wait for an upgrade to complete.",
+				Computed:    true,
+			},
 			"ignore_deletion_error": schema.BoolAttribute{
 				Description: "Indicates to the provider to disregard API errors when deleting the machine pool." +
 					" This will remove the resource from the management file, but not necessirely delete the underlying pool in case it errors." +
@@ -192,6 +221,8 @@ func (r *HcpMachinePoolDatasource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 	state.ID = state.Name
+	// The management upgrade settings are always reported by the data source
+	state.ManagementUpgrade = new(ManagementUpgrade)
 
 	notFound, diags := readState(ctx, state, r.collection)
 	if notFound {
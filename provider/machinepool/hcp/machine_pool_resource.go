@@ -26,6 +26,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
@@ -36,10 +37,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	diskValidator "github.com/openshift-online/ocm-common/pkg/machinepool/validations"
 	ocmUtils "github.com/openshift-online/ocm-common/pkg/ocm/utils"
@@ -69,7 +72,9 @@ type HcpMachinePoolResource struct {
 
 var _ resource.ResourceWithConfigure = &HcpMachinePoolResource{}
 var _ resource.ResourceWithImportState = &HcpMachinePoolResource{}
+var _ resource.ResourceWithModifyPlan = &HcpMachinePoolResource{}
 var _ resource.ResourceWithConfigValidators = &HcpMachinePoolResource{}
+var _ resource.ResourceWithValidateConfig = &HcpMachinePoolResource{}
 
 func New() resource.Resource {
 	return &HcpMachinePoolResource{}
@@ -191,6 +196,24 @@ func (r *HcpMachinePoolResource) Schema(ctx context.Context, req resource.Schema
 				Description: "Indicates use of autor repair for the pool",
 				Required:    true,
 			},
+			"management_upgrade": schema.SingleNestedAttribute{
+				Description: "Settings used to replace or update the nodes of the machine pool during upgrades.",
+				Attributes:  ManagementUpgradeResource(),
+				Optional:    true,
+			},
+			"node_drain_grace_period": schema.Int64Attribute{
+				Description: fmt.Sprintf("Time, in minutes, that the nodes are given to drain their pods before being "+
+					"forcibly removed during upgrades and scale downs. Must be between 0 and %d minutes.",
+					MaxNodeDrainGracePeriodInMinutes),
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, MaxNodeDrainGracePeriodInMinutes),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"version": schema.StringAttribute{
 				Description: "Desired version of OpenShift for the machine pool, for example '4.11.0'. If version is greater than the currently running version, an upgrade will be scheduled.",
 				Optional:    true,
@@ -202,12 +225,45 @@ func (r *HcpMachinePoolResource) Schema(ctx context.Context, req resource.Schema
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"control_plane_version": schema.StringAttribute{
+				Description: "The version of OpenShift running on the control plane of the cluster, for example '4.11.0'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"machine_pool_version_policy": schema.StringAttribute{
+				Description: fmt.Sprintf("Policy used to decide the version of the machine pool. With '%s' the machine pool "+
+					"is upgraded once the control plane runs a newer version, and the 'version' attribute can't be set. "+
+					"Options are %s. Defaults to '%s'.", VersionPolicyFollowControlPlane,
+					strings.Join(versionPolicies, ","), VersionPolicyManual),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(versionPolicies...),
+				},
+			},
 			"upgrade_acknowledgements_for": schema.StringAttribute{
 				Description: "Indicates acknowledgement of agreements required to upgrade the cluster version between" +
 					" minor versions (e.g. a value of \"4.12\" indicates acknowledgement of any agreements required to " +
 					"upgrade to OpenShift 4.12.z from 4.11 or before).",
 				Optional: true,
 			},
+			"upgrade_conflict_policy": schema.StringAttribute{
+				Description: common.UpgradeConflictPolicyDescription,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(common.UpgradeConflictPolicies...),
+				},
+			},
+			"wait_for_upgrade_complete": schema.BoolAttribute{
+				Description: fmt.Sprintf("Wait until the upgrade to the requested version is either completed or failed. "+
+					"The waiter has a timeout of %d minutes, with the default value set to false", rosa.MaxUpgradeWaitTimeoutInMinutes),
+				Optional: true,
+			},
+			"max_upgrade_wait_timeout_in_minutes": schema.Int64Attribute{
+				Description: "This value sets the maximum duration in minutes to wait for an upgrade to complete.",
+				Optional:    true,
+			},
 			"ignore_deletion_error": schema.BoolAttribute{
 				Description: "Indicates to the provider to disregard API errors when deleting the machine pool." +
 					" This will remove the resource from the management file, but not necessirely delete the underlying pool in case it errors." +
@@ -229,6 +285,22 @@ func (r *HcpMachinePoolResource) ConfigValidators(context.Context) []resource.Co
 	}
 }
 
+func (r *HcpMachinePoolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	managementUpgradeObject := types.ObjectNull(nil)
+	diags := req.Config.GetAttribute(ctx, path.Root("management_upgrade"), &managementUpgradeObject)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || managementUpgradeObject.IsNull() || managementUpgradeObject.IsUnknown() {
+		return
+	}
+	managementUpgrade := &ManagementUpgrade{}
+	diags = managementUpgradeObject.As(ctx, managementUpgrade, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateManagementUpgrade(managementUpgrade)...)
+}
+
 func (r *HcpMachinePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -423,6 +495,14 @@ func (r *HcpMachinePoolResource) Create(ctx context.Context, req resource.Create
 		builder.AutoRepair(common.BoolWithTrueDefault(plan.AutoRepair))
 	}
 
+	if plan.ManagementUpgrade != nil {
+		builder.ManagementUpgrade(buildManagementUpgrade(plan.ManagementUpgrade))
+	}
+
+	if common.HasValue(plan.NodeDrainGracePeriod) {
+		builder.NodeDrainGracePeriod(buildNodeDrainGracePeriod(plan.NodeDrainGracePeriod))
+	}
+
 	if common.HasValue(plan.Version) {
 		vBuilder := cmv1.NewVersion()
 		vBuilder.ID(ocmUtils.CreateVersionId(plan.Version.ValueString(), clusterObject.Version().ChannelGroup()))
@@ -622,6 +702,9 @@ func validateNoImmutableAttChange(state, plan *HcpMachinePoolState) diag.Diagnos
 			"aws_node_pool.ec2_metadata_http_tokens", &diags)
 		validateStateAndPlanEquals(state.AWSNodePool.DiskSize, plan.AWSNodePool.DiskSize, "aws_node_pool.disk_size", &diags)
 	}
+	if state.ManagementUpgrade != nil && plan.ManagementUpgrade != nil {
+		validateStateAndPlanEquals(state.ManagementUpgrade.Type, plan.ManagementUpgrade.Type, "management_upgrade.type", &diags)
+	}
 	return diags
 }
 
@@ -692,8 +775,20 @@ func (r *HcpMachinePoolResource) doUpdate(ctx context.Context, state *HcpMachine
 		return diags
 	}
 
+	// The upgrade settings are applied first, so an upgrade scheduled below already uses them
+	if err := r.updateUpgradeSettingsIfNeeded(ctx, state, plan); err != nil {
+		diags.AddError(
+			"Failed to update machine pool",
+			fmt.Sprintf(
+				"Failed to update the upgrade settings of machine pool '%s' on cluster '%s': %v",
+				state.ID.ValueString(), state.Cluster.ValueString(), err,
+			),
+		)
+		return diags
+	}
+
 	// Schedule a cluster upgrade if a newer version is requested
-	if err := r.upgradeMachinePoolIfNeeded(ctx, state, plan); err != nil {
+	if err := r.upgradeMachinePoolIfNeeded(ctx, state, plan, clusterObject); err != nil {
 		diags.AddError(
 			"Can't upgrade machine pool",
 			fmt.Sprintf("Can't upgrade machine pool version with identifier: `%s`, %v", state.ID.ValueString(), err),
@@ -835,6 +930,33 @@ func (r *HcpMachinePoolResource) doUpdate(ctx context.Context, state *HcpMachine
 	return diags
 }
 
+// updateUpgradeSettingsIfNeeded patches the management upgrade and the node drain grace period
+// of the machine pool when they were changed.
+func (r *HcpMachinePoolResource) updateUpgradeSettingsIfNeeded(ctx context.Context, state, plan *HcpMachinePoolState) error {
+	npBuilder := cmv1.NewNodePool().ID(state.ID.ValueString())
+	shouldPatch := false
+	if managementUpgradeBuilder, ok := shouldPatchManagementUpgrade(state.ManagementUpgrade, plan.ManagementUpgrade); ok {
+		npBuilder.ManagementUpgrade(managementUpgradeBuilder)
+		shouldPatch = true
+	}
+	if _, ok := common.ShouldPatchInt(state.NodeDrainGracePeriod, plan.NodeDrainGracePeriod); ok {
+		npBuilder.NodeDrainGracePeriod(buildNodeDrainGracePeriod(plan.NodeDrainGracePeriod))
+		shouldPatch = true
+	}
+	if !shouldPatch {
+		return nil
+	}
+	nodePool, err := npBuilder.Build()
+	if err != nil {
+		return err
+	}
+	_, err = r.clusterCollection.Cluster(state.Cluster.ValueString()).
+		NodePools().
+		NodePool(state.ID.ValueString()).Update().
+		Body(nodePool).SendContext(ctx)
+	return err
+}
+
 func adjustInitialStateToPlan(state, plan *HcpMachinePoolState) {
 	// update some values the plan value (important for nil and false cases)
 	if state.AutoScaling == nil {
@@ -846,6 +968,10 @@ func adjustInitialStateToPlan(state, plan *HcpMachinePoolState) {
 	state.NodePoolStatus = plan.NodePoolStatus
 	state.Version = plan.Version
 	state.IgnoreDeletionError = plan.IgnoreDeletionError
+	state.MachinePoolVersionPolicy = plan.MachinePoolVersionPolicy
+	state.UpgradeConflictPolicy = plan.UpgradeConflictPolicy
+	state.WaitForUpgradeComplete = plan.WaitForUpgradeComplete
+	state.MaxUpgradeWaitTimeoutInMinutes = plan.MaxUpgradeWaitTimeoutInMinutes
 
 	if state.AWSNodePool == nil {
 		state.AWSNodePool = new(AWSNodePool)
@@ -858,15 +984,71 @@ func adjustInitialStateToPlan(state, plan *HcpMachinePoolState) {
 		state.TuningConfigs = plan.TuningConfigs
 	}
 
+	if plan.ManagementUpgrade != nil && state.ManagementUpgrade == nil {
+		state.ManagementUpgrade = &ManagementUpgrade{
+			Type:           plan.ManagementUpgrade.Type,
+			MaxSurge:       plan.ManagementUpgrade.MaxSurge,
+			MaxUnavailable: plan.ManagementUpgrade.MaxUnavailable,
+		}
+	} else if plan.ManagementUpgrade == nil {
+		state.ManagementUpgrade = nil
+	}
+
 	if plan.KubeletConfigs.ValueString() == "" {
 		state.KubeletConfigs = plan.KubeletConfigs
 	}
 }
 
+// ModifyPlan applies the machine pool version policy, and warns about the upgrades scheduled
+// outside of Terraform that conflict with the requested version.
+func (r *HcpMachinePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.modifyPlanForVersionPolicy(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desiredVersion, ok, diags := common.PlannedUpgradeVersion(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if !ok {
+		return
+	}
+	var cluster, id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("cluster"), &cluster)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	var conflictPolicy types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("upgrade_conflict_policy"), &conflictPolicy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgrades, err := upgrade.GetScheduledUpgrades(ctx, r.clusterCollection, cluster.ValueString(), id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Can't check conflicting upgrades",
+			fmt.Sprintf("Can't get the upgrade policies of machine pool '%s': %v", id.ValueString(), err),
+		)
+		return
+	}
+	conflicts, err := upgrade.GetConflictingUpgrades(upgrades, desiredVersion)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Can't check conflicting upgrades",
+			fmt.Sprintf("Can't check the upgrade policies of machine pool '%s': %v", id.ValueString(), err),
+		)
+		return
+	}
+	if len(conflicts) > 0 {
+		resp.Diagnostics.AddWarning(common.UpgradeConflictWarning(
+			conflictPolicy.ValueString(), conflicts, desiredVersion.String()))
+	}
+}
+
 // Upgrades the cluster if the desired (plan) version is greater than the
 // current version
-func (r *HcpMachinePoolResource) upgradeMachinePoolIfNeeded(ctx context.Context, state, plan *HcpMachinePoolState) error {
-	if common.IsStringAttributeUnknownOrEmpty(plan.Version) || common.IsStringAttributeUnknownOrEmpty(state.CurrentVersion) {
+func (r *HcpMachinePoolResource) upgradeMachinePoolIfNeeded(ctx context.Context, state, plan *HcpMachinePoolState,
+	clusterObject *cmv1.Cluster) error {
+	planVersion := requestedVersion(plan, clusterObject)
+	if common.IsStringAttributeUnknownOrEmpty(planVersion) || common.IsStringAttributeUnknownOrEmpty(state.CurrentVersion) {
 		// No version information, nothing to do
 		tflog.Debug(ctx, "Insufficient cluster version information to determine if upgrade should be performed.")
 		return nil
@@ -875,13 +1057,16 @@ func (r *HcpMachinePoolResource) upgradeMachinePoolIfNeeded(ctx context.Context,
 	tflog.Debug(ctx, "HCP Machine Pool versions",
 		map[string]interface{}{
 			"current_version": state.CurrentVersion.ValueString(),
-			"plan-version":    plan.Version.ValueString(),
+			"plan-version":    planVersion.ValueString(),
 			"state-version":   state.Version.ValueString(),
 		})
 
 	// See if the user has changed the requested version for this run
 	requestedVersionChanged := true
-	if !common.IsStringAttributeUnknownOrEmpty(plan.Version) && !common.IsStringAttributeUnknownOrEmpty(state.Version) {
+	if followsControlPlane(plan.MachinePoolVersionPolicy) {
+		// The version follows the control plane, it isn't requested by the user
+		requestedVersionChanged = false
+	} else if !common.IsStringAttributeUnknownOrEmpty(plan.Version) && !common.IsStringAttributeUnknownOrEmpty(state.Version) {
 		if plan.Version.ValueString() == state.Version.ValueString() {
 			requestedVersionChanged = false
 		}
@@ -892,7 +1077,7 @@ func (r *HcpMachinePoolResource) upgradeMachinePoolIfNeeded(ctx context.Context,
 	if err != nil {
 		return fmt.Errorf("failed to parse current cluster version: %v", err)
 	}
-	desiredVersion, err := semver.NewVersion(plan.Version.ValueString())
+	desiredVersion, err := semver.NewVersion(planVersion.ValueString())
 	if err != nil {
 		return fmt.Errorf("failed to parse desired cluster version: %v", err)
 	}
@@ -908,7 +1093,7 @@ func (r *HcpMachinePoolResource) upgradeMachinePoolIfNeeded(ctx context.Context,
 	cancelingUpgradeOnly := desiredVersion.Equal(currentVersion)
 
 	if !cancelingUpgradeOnly {
-		if err = r.validateUpgrade(ctx, state, plan); err != nil {
+		if err = r.validateUpgrade(ctx, state, planVersion.ValueString()); err != nil {
 			return err
 		}
 	}
@@ -921,33 +1106,55 @@ func (r *HcpMachinePoolResource) upgradeMachinePoolIfNeeded(ctx context.Context,
 	}
 
 	// Stop if an upgrade is already in progress
-	correctUpgradePending, err := upgrade.CheckAndCancelUpgrades(
-		ctx, r.clusterCollection, upgrades, desiredVersion)
+	correctUpgradePending, keptConflicts, err := upgrade.CheckAndCancelUpgrades(
+		ctx, r.clusterCollection, upgrades, desiredVersion, plan.UpgradeConflictPolicy.ValueString())
 	if err != nil {
 		return err
 	}
 
+	// Don't record the requested version as applied if the upgrade can't be scheduled because of
+	// the conflicting upgrades that were kept, so that it is requested again by the next apply
+	if !correctUpgradePending && !cancelingUpgradeOnly && len(keptConflicts) > 0 {
+		return common.KeptUpgradeConflictsError(keptConflicts, desiredVersion.String())
+	}
+
 	// Schedule a new upgrade
+	upgradePolicyID := upgrade.GetPendingUpgradeID(upgrades, desiredVersion)
 	if !correctUpgradePending && !cancelingUpgradeOnly {
 		ackString := plan.UpgradeAcksFor.ValueString()
-		if err = scheduleUpgrade(ctx, r.clusterCollection,
-			state.Cluster.ValueString(), state.ID.ValueString(), desiredVersion, ackString); err != nil {
+		upgradePolicyID, err = scheduleUpgrade(ctx, r.clusterCollection,
+			state.Cluster.ValueString(), state.ID.ValueString(), desiredVersion, ackString)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Wait for the upgrade to complete
+	if common.BoolWithFalseDefault(plan.WaitForUpgradeComplete) && !cancelingUpgradeOnly && upgradePolicyID != "" {
+		timeOut, err := common.ValidateTimeout(
+			common.OptionalInt64(plan.MaxUpgradeWaitTimeoutInMinutes), rosa.MaxUpgradeWaitTimeoutInMinutes)
+		if err != nil {
+			return err
+		}
+		if err = upgrade.WaitForUpgradeToComplete(ctx, r.clusterCollection,
+			state.Cluster.ValueString(), state.ID.ValueString(), upgradePolicyID, *timeOut); err != nil {
 			return err
 		}
 	}
 
 	state.Version = plan.Version
 	state.UpgradeAcksFor = plan.UpgradeAcksFor
+	state.UpgradeConflictPolicy = plan.UpgradeConflictPolicy
 	return nil
 }
 
-func (r *HcpMachinePoolResource) validateUpgrade(ctx context.Context, state, plan *HcpMachinePoolState) error {
+func (r *HcpMachinePoolResource) validateUpgrade(ctx context.Context, state *HcpMachinePoolState, version string) error {
 	availableVersions, err := upgrade.GetAvailableUpgradeVersions(
 		ctx, r.clusterCollection, r.versionCollection, state.Cluster.ValueString(), state.ID.ValueString())
 	if err != nil {
 		return fmt.Errorf("failed to get available upgrades: %v", err)
 	}
-	trimmedDesiredVersion := strings.TrimPrefix(plan.Version.ValueString(), rosa.VersionPrefix)
+	trimmedDesiredVersion := strings.TrimPrefix(version, rosa.VersionPrefix)
 	desiredVersion, err := semver.NewVersion(trimmedDesiredVersion)
 	if err != nil {
 		return fmt.Errorf("failed to parse desired version: %v", err)
@@ -976,7 +1183,7 @@ func (r *HcpMachinePoolResource) validateUpgrade(ctx context.Context, state, pla
 
 // Ensure user has acked upgrade gates and schedule the upgrade
 func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient,
-	clusterID string, machinePoolId string, desiredVersion *semver.Version, userAckString string) error {
+	clusterID string, machinePoolId string, desiredVersion *semver.Version, userAckString string) (string, error) {
 	// Gate agreements are checked when the upgrade is scheduled, resulting
 	// in an error return. ROSA cli does this by scheduling once w/ dryRun
 	// to look for un-acked agreements.
@@ -984,7 +1191,7 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient,
 	upgradePoliciesClient := clusterClient.NodePools().NodePool(machinePoolId).UpgradePolicies()
 	gates, description, err := upgrade.CheckMissingAgreements(desiredVersion.String(), clusterID, upgradePoliciesClient)
 	if err != nil {
-		return fmt.Errorf("failed to check for missing upgrade agreements: %v", err)
+		return "", fmt.Errorf("failed to check for missing upgrade agreements: %v", err)
 	}
 	// User ack is required if we have any non-STS-only gates
 	userAckRequired := false
@@ -995,7 +1202,7 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient,
 	}
 	targetMinorVersion := getOcmVersionMinor(desiredVersion.String())
 	if userAckRequired && userAckString != targetMinorVersion { // User has not acknowledged mandatory gates, stop here.
-		return fmt.Errorf("%s\nTo acknowledge these items, please add \"upgrade_acknowledgements_for = %s\""+
+		return "", fmt.Errorf("%s\nTo acknowledge these items, please add \"upgrade_acknowledgements_for = %s\""+
 			" and re-apply the changes", description, targetMinorVersion)
 	}
 
@@ -1006,7 +1213,7 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient,
 		gateAgreementsClient := clusterClient.GateAgreements()
 		err := upgrade.AckVersionGate(gateAgreementsClient, gateID)
 		if err != nil {
-			return fmt.Errorf("failed to acknowledge version gate '%s' for cluster '%s': %v",
+			return "", fmt.Errorf("failed to acknowledge version gate '%s' for cluster '%s': %v",
 				gateID, clusterID, err)
 		}
 	}
@@ -1019,16 +1226,16 @@ func scheduleUpgrade(ctx context.Context, client *cmv1.ClustersClient,
 		NextRun(tenMinFromNow).
 		Build()
 	if err != nil {
-		return fmt.Errorf("failed to create upgrade policy: %v", err)
+		return "", fmt.Errorf("failed to create upgrade policy: %v", err)
 	}
-	_, err = upgradePoliciesClient.
+	resp, err := upgradePoliciesClient.
 		Add().
 		Body(newPolicy).
 		SendContext(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to schedule upgrade: %v", err)
+		return "", fmt.Errorf("failed to schedule upgrade: %v", err)
 	}
-	return nil
+	return resp.Body().ID(), nil
 }
 
 // TODO: move to ocm commons
@@ -1299,7 +1506,11 @@ func populateState(ctx context.Context, object *cmv1.NodePool, state *HcpMachine
 		version, ok := object.Version().GetID()
 		// If we're using a non-default channel group, it will have been appended to
 		// the version ID. Remove it before saving state.
-		version = strings.TrimPrefix(version, rosa.VersionPrefix)
+		channelGroup := object.Version().ChannelGroup()
+		if channelGroup == "" && cluster != nil && cluster.Version() != nil {
+			channelGroup = cluster.Version().ChannelGroup()
+		}
+		version = rawVersion(version, channelGroup)
 		if ok {
 			state.CurrentVersion = types.StringValue(version)
 		} else {
@@ -1307,7 +1518,15 @@ func populateState(ctx context.Context, object *cmv1.NodePool, state *HcpMachine
 		}
 	}
 
+	if cluster != nil && cluster.Version() != nil {
+		state.ControlPlaneVersion = types.StringValue(cluster.Version().RawID())
+	} else if state.ControlPlaneVersion.IsUnknown() {
+		state.ControlPlaneVersion = types.StringNull()
+	}
+
 	state.AutoRepair = types.BoolValue(object.AutoRepair())
+	populateManagementUpgrade(object, state)
+	populateNodeDrainGracePeriod(object, state)
 	return nil
 }
 
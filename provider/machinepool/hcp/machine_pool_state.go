@@ -32,10 +32,15 @@ type HcpMachinePoolState struct {
 	AvailabilityZone types.String `tfsdk:"availability_zone"`
 	SubnetID         types.String `tfsdk:"subnet_id"`
 
-	Version        types.String `tfsdk:"version"`
-	CurrentVersion types.String `tfsdk:"current_version"`
+	Version                  types.String `tfsdk:"version"`
+	CurrentVersion           types.String `tfsdk:"current_version"`
+	ControlPlaneVersion      types.String `tfsdk:"control_plane_version"`
+	MachinePoolVersionPolicy types.String `tfsdk:"machine_pool_version_policy"`
 
-	UpgradeAcksFor types.String `tfsdk:"upgrade_acknowledgements_for"`
+	UpgradeAcksFor                 types.String `tfsdk:"upgrade_acknowledgements_for"`
+	UpgradeConflictPolicy          types.String `tfsdk:"upgrade_conflict_policy"`
+	WaitForUpgradeComplete         types.Bool   `tfsdk:"wait_for_upgrade_complete"`
+	MaxUpgradeWaitTimeoutInMinutes types.Int64  `tfsdk:"max_upgrade_wait_timeout_in_minutes"`
 
 	NodePoolStatus types.Object `tfsdk:"status"`
 	AWSNodePool    *AWSNodePool `tfsdk:"aws_node_pool"`
@@ -43,6 +48,9 @@ type HcpMachinePoolState struct {
 	KubeletConfigs types.String `tfsdk:"kubelet_configs"`
 	AutoRepair     types.Bool   `tfsdk:"auto_repair"`
 
+	ManagementUpgrade    *ManagementUpgrade `tfsdk:"management_upgrade"`
+	NodeDrainGracePeriod types.Int64        `tfsdk:"node_drain_grace_period"`
+
 	IgnoreDeletionError types.Bool `tfsdk:"ignore_deletion_error"`
 }
 
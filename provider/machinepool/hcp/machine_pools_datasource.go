@@ -0,0 +1,367 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type HcpMachinePoolsDatasource struct {
+	collection *cmv1.ClustersClient
+}
+
+var _ datasource.DataSource = &HcpMachinePoolsDatasource{}
+var _ datasource.DataSourceWithConfigure = &HcpMachinePoolsDatasource{}
+
+func NewMachinePoolsDatasource() datasource.DataSource {
+	return &HcpMachinePoolsDatasource{}
+}
+
+func (r *HcpMachinePoolsDatasource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hcp_machine_pools"
+}
+
+func (r *HcpMachinePoolsDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*sdk.Connection)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.collection = connection.ClustersMgmt().V1().Clusters()
+}
+
+func (r *HcpMachinePoolsDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of the machine pools of a hosted control plane cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Only return the machine pools that have all these labels.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"instance_type": schema.StringAttribute{
+				Description: "Only return the machine pools that use this instance type, for example `m5.xlarge`.",
+				Optional:    true,
+			},
+			"availability_zone": schema.StringAttribute{
+				Description: "Only return the machine pools created in this availability zone.",
+				Optional:    true,
+			},
+			"autoscaling_enabled": schema.BoolAttribute{
+				Description: "Only return the machine pools with autoscaling enabled (`true`) or disabled (`false`).",
+				Optional:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "Only return the machine pools whose current version satisfies this version constraint, " +
+					"for example '4.15.2' or '< 4.15.0'.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Machine pools of the cluster matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: r.itemAttributes(),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *HcpMachinePoolsDatasource) itemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique identifier of the machine pool.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the machine pool.",
+			Computed:    true,
+		},
+		"replicas": schema.Int64Attribute{
+			Description: "The number of machines of the pool",
+			Computed:    true,
+		},
+		"autoscaling": schema.SingleNestedAttribute{
+			Description: "Basic autoscaling options",
+			Attributes:  AutoscalingDatasource(),
+			Computed:    true,
+		},
+		"taints": schema.ListNestedAttribute{
+			Description: "Taints for the machine pool.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Description: "Taints key",
+						Computed:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "Taints value",
+						Computed:    true,
+					},
+					"schedule_type": schema.StringAttribute{
+						Description: "Taints schedule type",
+						Computed:    true,
+					},
+				},
+			},
+			Computed: true,
+		},
+		"labels": schema.MapAttribute{
+			Description: "Labels for the machine pool.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"availability_zone": schema.StringAttribute{
+			Description: "Availability zone in which the machine pool is created.",
+			Computed:    true,
+		},
+		"subnet_id": schema.StringAttribute{
+			Description: "Subnet in which the machine pool is created.",
+			Computed:    true,
+		},
+		"status": schema.SingleNestedAttribute{
+			Description: "HCP replica status",
+			Attributes:  NodePoolStatusDatasource(),
+			Computed:    true,
+		},
+		"aws_node_pool": schema.SingleNestedAttribute{
+			Description: "AWS settings for node pool",
+			Attributes:  AwsNodePoolDatasource(),
+			Computed:    true,
+		},
+		"tuning_configs": schema.ListAttribute{
+			Description: "A list of tuning configs attached to the replica.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"kubelet_configs": schema.StringAttribute{
+			Description: "Name of the kubelet config applied to the machine pool.",
+			Computed:    true,
+		},
+		"auto_repair": schema.BoolAttribute{
+			Description: "Indicates use of autor repair for replica",
+			Computed:    true,
+		},
+		"current_version": schema.StringAttribute{
+			Description: "The currently running version of OpenShift on the machine pool, for example '4.11.0'.",
+			Computed:    true,
+		},
+	}
+}
+
+func (r *HcpMachinePoolsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the current state:
+	state := &HcpMachinePoolsState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var versionConstraint semver.Constraints
+	if common.HasValue(state.Version) {
+		constraint, err := semver.NewConstraint(state.Version.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid version constraint",
+				fmt.Sprintf("Can't parse version constraint '%s': %v", state.Version.ValueString(), err),
+			)
+			return
+		}
+		versionConstraint = constraint
+	}
+
+	clusterObject := fetchCluster(ctx, &HcpMachinePoolState{Cluster: state.Cluster}, r.collection, &resp.Diagnostics)
+	if clusterObject == nil {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Failed to find cluster",
+				fmt.Sprintf("Failed to find cluster with identifier %s.", state.Cluster.ValueString()),
+			)
+		}
+		return
+	}
+
+	labels, err := common.OptionalMap(ctx, state.Labels)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't list machine pools",
+			fmt.Sprintf("Failed to read the labels filter: %v", err),
+		)
+		return
+	}
+
+	pools, err := listNodePools(ctx, r.collection, state.Cluster.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't list machine pools",
+			fmt.Sprintf(
+				"Failed to list machine pools for cluster %s: %v",
+				state.Cluster.ValueString(), err,
+			),
+		)
+		return
+	}
+
+	state.Items = []*HcpMachinePoolsItem{}
+	for _, pool := range pools {
+		matches, err := matchesNodePoolFilters(pool, state, labels, versionConstraint)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't filter machine pools",
+				fmt.Sprintf("Failed to filter machine pool %s: %v", pool.ID(), err),
+			)
+			return
+		}
+		if !matches {
+			continue
+		}
+		item, diags := nodePoolToItem(ctx, pool, state.Cluster, clusterObject)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Items = append(state.Items, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// listNodePools returns all the node pools of the given cluster
+func listNodePools(ctx context.Context, collection *cmv1.ClustersClient, clusterID string) ([]*cmv1.NodePool, error) {
+	pools := []*cmv1.NodePool{}
+	poolsClient := collection.Cluster(clusterID).NodePools()
+	page := 1
+	size := 100
+	for {
+		resp, err := poolsClient.List().
+			Page(page).
+			Size(size).
+			SendContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, resp.Items().Slice()...)
+		if resp.Size() < size {
+			break
+		}
+		page++
+	}
+	return pools, nil
+}
+
+func matchesNodePoolFilters(pool *cmv1.NodePool, state *HcpMachinePoolsState, labels map[string]string,
+	versionConstraint semver.Constraints) (bool, error) {
+	if !common.ContainsAllLabels(pool.Labels(), labels) {
+		return false, nil
+	}
+	if common.HasValue(state.InstanceType) && pool.AWSNodePool().InstanceType() != state.InstanceType.ValueString() {
+		return false, nil
+	}
+	if common.HasValue(state.AvailabilityZone) && pool.AvailabilityZone() != state.AvailabilityZone.ValueString() {
+		return false, nil
+	}
+	if common.HasValue(state.AutoScalingEnabled) {
+		_, autoscalingEnabled := pool.GetAutoscaling()
+		if autoscalingEnabled != state.AutoScalingEnabled.ValueBool() {
+			return false, nil
+		}
+	}
+	if versionConstraint != nil {
+		rawVersion := pool.Version().RawID()
+		if rawVersion == "" {
+			rawVersion = strings.TrimPrefix(pool.Version().ID(), rosa.VersionPrefix)
+		}
+		if rawVersion == "" {
+			return false, nil
+		}
+		currentVersion, err := semver.NewVersion(rawVersion)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse current version: %v", err)
+		}
+		if !versionConstraint.Check(currentVersion) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func nodePoolToItem(ctx context.Context, pool *cmv1.NodePool, cluster types.String,
+	clusterObject *cmv1.Cluster) (*HcpMachinePoolsItem, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	poolState := &HcpMachinePoolState{
+		Cluster:        cluster,
+		ID:             types.StringValue(pool.ID()),
+		NodePoolStatus: nodePoolStatusNull(),
+	}
+	if err := populateState(ctx, pool, poolState, clusterObject); err != nil {
+		diags.AddError(
+			"Can't populate machine pool state",
+			fmt.Sprintf(
+				"Received error %v", err,
+			),
+		)
+		return nil, diags
+	}
+	return &HcpMachinePoolsItem{
+		ID:               poolState.ID,
+		Name:             poolState.Name,
+		Replicas:         poolState.Replicas,
+		AutoScaling:      poolState.AutoScaling,
+		Taints:           poolState.Taints,
+		Labels:           poolState.Labels,
+		AvailabilityZone: poolState.AvailabilityZone,
+		SubnetID:         poolState.SubnetID,
+		NodePoolStatus:   poolState.NodePoolStatus,
+		AWSNodePool:      poolState.AWSNodePool,
+		TuningConfigs:    poolState.TuningConfigs,
+		KubeletConfigs:   poolState.KubeletConfigs,
+		AutoRepair:       poolState.AutoRepair,
+		CurrentVersion:   poolState.CurrentVersion,
+	}, diags
+}
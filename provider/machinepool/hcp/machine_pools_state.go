@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcp
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type HcpMachinePoolsState struct {
+	Cluster            types.String           `tfsdk:"cluster"`
+	Labels             types.Map              `tfsdk:"labels"`
+	InstanceType       types.String           `tfsdk:"instance_type"`
+	AvailabilityZone   types.String           `tfsdk:"availability_zone"`
+	AutoScalingEnabled types.Bool             `tfsdk:"autoscaling_enabled"`
+	Version            types.String           `tfsdk:"version"`
+	Items              []*HcpMachinePoolsItem `tfsdk:"items"`
+}
+
+type HcpMachinePoolsItem struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Replicas         types.Int64  `tfsdk:"replicas"`
+	AutoScaling      *AutoScaling `tfsdk:"autoscaling"`
+	Taints           []Taints     `tfsdk:"taints"`
+	Labels           types.Map    `tfsdk:"labels"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	SubnetID         types.String `tfsdk:"subnet_id"`
+	NodePoolStatus   types.Object `tfsdk:"status"`
+	AWSNodePool      *AWSNodePool `tfsdk:"aws_node_pool"`
+	TuningConfigs    types.List   `tfsdk:"tuning_configs"`
+	KubeletConfigs   types.String `tfsdk:"kubelet_configs"`
+	AutoRepair       types.Bool   `tfsdk:"auto_repair"`
+	CurrentVersion   types.String `tfsdk:"current_version"`
+}
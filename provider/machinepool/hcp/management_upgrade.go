@@ -0,0 +1,230 @@
+package hcp
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+const (
+	ManagementUpgradeTypeReplace = "Replace"
+	ManagementUpgradeTypeInPlace = "InPlace"
+
+	// MaxNodeDrainGracePeriodInMinutes is one week, the longest grace period accepted by the API
+	MaxNodeDrainGracePeriodInMinutes = 10080
+	nodeDrainGracePeriodUnitMinutes  = "minutes"
+	nodeDrainGracePeriodUnitHours    = "hours"
+)
+
+var managementUpgradeTypes = []string{ManagementUpgradeTypeReplace, ManagementUpgradeTypeInPlace}
+
+var surgeValueRE = regexp.MustCompile(`^[0-9]+%?$`)
+
+type ManagementUpgrade struct {
+	Type           types.String `tfsdk:"type"`
+	MaxSurge       types.String `tfsdk:"max_surge"`
+	MaxUnavailable types.String `tfsdk:"max_unavailable"`
+}
+
+func ManagementUpgradeResource() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Description: fmt.Sprintf("Strategy used to upgrade the nodes of the machine pool. Options are %s. "+
+				"Defaults to '%s'. ", strings.Join(managementUpgradeTypes, ","), ManagementUpgradeTypeReplace) +
+				common.ValueCannotBeChangedStringDescription,
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(managementUpgradeTypes...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"max_surge": schema.StringAttribute{
+			Description: "Maximum number of nodes that can be provisioned above the desired number of nodes " +
+				"during an upgrade, either as an absolute number (for example '1') or as a percentage (for example '10%'). " +
+				"Only valid with the 'Replace' upgrade type.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(surgeValueRE, "must be a non-negative integer or a percentage"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"max_unavailable": schema.StringAttribute{
+			Description: "Maximum number of nodes that can be unavailable during an upgrade, either as an absolute " +
+				"number (for example '0') or as a percentage (for example '10%'). Only valid with the 'Replace' upgrade type.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(surgeValueRE, "must be a non-negative integer or a percentage"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func ManagementUpgradeDatasource() map[string]dsschema.Attribute {
+	return map[string]dsschema.Attribute{
+		"type": dsschema.StringAttribute{
+			Description: "Strategy used to upgrade the nodes of the machine pool.",
+			Computed:    true,
+		},
+		"max_surge": dsschema.StringAttribute{
+			Description: "Maximum number of nodes that can be provisioned above the desired number of nodes during an upgrade.",
+			Computed:    true,
+		},
+		"max_unavailable": dsschema.StringAttribute{
+			Description: "Maximum number of nodes that can be unavailable during an upgrade.",
+			Computed:    true,
+		},
+	}
+}
+
+// validateManagementUpgrade checks the combinations of the management upgrade attributes that
+// can't be expressed as attribute validators.
+func validateManagementUpgrade(managementUpgrade *ManagementUpgrade) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if managementUpgrade == nil {
+		return diags
+	}
+	attrPath := path.Root("management_upgrade")
+	if managementUpgrade.Type.ValueString() == ManagementUpgradeTypeInPlace {
+		if common.HasValue(managementUpgrade.MaxSurge) || common.HasValue(managementUpgrade.MaxUnavailable) {
+			diags.AddAttributeError(attrPath, "Invalid management upgrade",
+				fmt.Sprintf("Attributes 'max_surge' and 'max_unavailable' can only be set with the '%s' upgrade type",
+					ManagementUpgradeTypeReplace))
+			return diags
+		}
+	}
+	surgeIsZero, err := surgeValueIsZero(managementUpgrade.MaxSurge, "max_surge")
+	if err != nil {
+		diags.AddAttributeError(attrPath.AtName("max_surge"), "Invalid management upgrade", err.Error())
+	}
+	unavailableIsZero, err := surgeValueIsZero(managementUpgrade.MaxUnavailable, "max_unavailable")
+	if err != nil {
+		diags.AddAttributeError(attrPath.AtName("max_unavailable"), "Invalid management upgrade", err.Error())
+	}
+	if surgeIsZero && unavailableIsZero {
+		diags.AddAttributeError(attrPath, "Invalid management upgrade",
+			"Attributes 'max_surge' and 'max_unavailable' can't both be zero, otherwise the nodes can never be replaced")
+	}
+	return diags
+}
+
+// surgeValueIsZero returns true when the value is known and equal to zero, either as an absolute
+// number or as a percentage.
+func surgeValueIsZero(value types.String, attrName string) (bool, error) {
+	if !common.HasValue(value) || !surgeValueRE.MatchString(value.ValueString()) {
+		return false, nil
+	}
+	raw := value.ValueString()
+	number, err := strconv.Atoi(strings.TrimSuffix(raw, "%"))
+	if err != nil {
+		return false, fmt.Errorf("Attribute '%s' value '%s' is not valid: %v", attrName, raw, err)
+	}
+	if strings.HasSuffix(raw, "%") && number > 100 {
+		return false, fmt.Errorf("Attribute '%s' value '%s' can't be a percentage greater than 100%%", attrName, raw)
+	}
+	return number == 0, nil
+}
+
+func buildManagementUpgrade(managementUpgrade *ManagementUpgrade) *cmv1.NodePoolManagementUpgradeBuilder {
+	builder := cmv1.NewNodePoolManagementUpgrade()
+	if common.HasValue(managementUpgrade.Type) {
+		builder.Type(managementUpgrade.Type.ValueString())
+	}
+	if common.HasValue(managementUpgrade.MaxSurge) {
+		builder.MaxSurge(managementUpgrade.MaxSurge.ValueString())
+	}
+	if common.HasValue(managementUpgrade.MaxUnavailable) {
+		builder.MaxUnavailable(managementUpgrade.MaxUnavailable.ValueString())
+	}
+	return builder
+}
+
+// shouldPatchManagementUpgrade returns the builder to patch the machine pool with when the
+// surge settings were changed. The upgrade type can't be changed once the pool exists.
+func shouldPatchManagementUpgrade(state, plan *ManagementUpgrade) (*cmv1.NodePoolManagementUpgradeBuilder, bool) {
+	if plan == nil {
+		return nil, false
+	}
+	if state == nil {
+		return buildManagementUpgrade(plan), true
+	}
+	_, surgeChanged := common.ShouldPatchString(state.MaxSurge, plan.MaxSurge)
+	_, unavailableChanged := common.ShouldPatchString(state.MaxUnavailable, plan.MaxUnavailable)
+	if !surgeChanged && !unavailableChanged {
+		return nil, false
+	}
+	return buildManagementUpgrade(plan), true
+}
+
+// populateManagementUpgrade only tracks the management upgrade when it was requested, as the
+// API returns defaults for every machine pool.
+func populateManagementUpgrade(object *cmv1.NodePool, state *HcpMachinePoolState) {
+	if state.ManagementUpgrade == nil {
+		return
+	}
+	managementUpgrade, ok := object.GetManagementUpgrade()
+	if !ok {
+		state.ManagementUpgrade.Type = nullIfUnknown(state.ManagementUpgrade.Type)
+		state.ManagementUpgrade.MaxSurge = nullIfUnknown(state.ManagementUpgrade.MaxSurge)
+		state.ManagementUpgrade.MaxUnavailable = nullIfUnknown(state.ManagementUpgrade.MaxUnavailable)
+		return
+	}
+	state.ManagementUpgrade.Type = optionalStringValue(managementUpgrade.GetType())
+	state.ManagementUpgrade.MaxSurge = optionalStringValue(managementUpgrade.GetMaxSurge())
+	state.ManagementUpgrade.MaxUnavailable = optionalStringValue(managementUpgrade.GetMaxUnavailable())
+}
+
+func buildNodeDrainGracePeriod(minutes types.Int64) *cmv1.ValueBuilder {
+	return cmv1.NewValue().Value(float64(minutes.ValueInt64())).Unit(nodeDrainGracePeriodUnitMinutes)
+}
+
+func populateNodeDrainGracePeriod(object *cmv1.NodePool, state *HcpMachinePoolState) {
+	gracePeriod, ok := object.GetNodeDrainGracePeriod()
+	if !ok {
+		if !common.HasValue(state.NodeDrainGracePeriod) {
+			state.NodeDrainGracePeriod = types.Int64Null()
+		}
+		return
+	}
+	minutes := gracePeriod.Value()
+	if gracePeriod.Unit() == nodeDrainGracePeriodUnitHours {
+		minutes *= 60
+	}
+	state.NodeDrainGracePeriod = types.Int64Value(int64(minutes))
+}
+
+func optionalStringValue(value string, ok bool) types.String {
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func nullIfUnknown(value types.String) types.String {
+	if value.IsUnknown() {
+		return types.StringNull()
+	}
+	return value
+}
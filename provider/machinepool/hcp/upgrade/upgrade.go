@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"time"
 
 	semver "github.com/hashicorp/go-version"
@@ -34,6 +35,39 @@ type MachinePoolUpgrade struct {
 	PolicyState *cmv1.UpgradePolicyState
 }
 
+func (u *MachinePoolUpgrade) Description() string {
+	return common.DescribeUpgradeConflict(u.Policy.ID(), u.Policy.Version(), u.Policy.NextRun())
+}
+
+// Hosted control planes support machine pools up to this number of minor versions older than the
+// control plane.
+const MaxMinorVersionSkew = 2
+
+// ValidateVersionSkew checks that the version of a machine pool is supported by the version of the
+// control plane: it can't be newer, nor more than MaxMinorVersionSkew minor versions older.
+func ValidateVersionSkew(controlPlaneVersion string, machinePoolVersion string) error {
+	controlPlane, err := semver.NewVersion(controlPlaneVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse control plane version: %v", err)
+	}
+	machinePool, err := semver.NewVersion(machinePoolVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse machine pool version: %v", err)
+	}
+	if machinePool.GreaterThan(controlPlane) {
+		return fmt.Errorf("machine pool version '%s' can't be newer than the control plane version '%s'",
+			machinePoolVersion, controlPlaneVersion)
+	}
+	controlPlaneSegments := controlPlane.Segments()
+	machinePoolSegments := machinePool.Segments()
+	if controlPlaneSegments[0] != machinePoolSegments[0] ||
+		controlPlaneSegments[1]-machinePoolSegments[1] > MaxMinorVersionSkew {
+		return fmt.Errorf("machine pool version '%s' can't be more than %d minor versions older than "+
+			"the control plane version '%s'", machinePoolVersion, MaxMinorVersionSkew, controlPlaneVersion)
+	}
+	return nil
+}
+
 // Get the available upgrade versions that are reachable from a given starting
 // version
 func GetAvailableUpgradeVersions(
@@ -56,17 +90,17 @@ func GetAvailableUpgradeVersions(
 	cluster := clusterResp.Body()
 	version := nodePool.Version()
 
-	// Cycle through the available upgrades and find the ones that are HCP enabled
-	availableUpgradeVersions := []*cmv1.Version{}
+	// Fetch the available upgrades and find the ones that are HCP enabled
+	ids := []string{}
 	for _, v := range version.AvailableUpgrades() {
-		id := ocmUtils.CreateVersionId(v, cluster.Version().ChannelGroup())
-		resp, err := versionClient.Version(id).
-			Get().
-			Send()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get version information: %v", err)
-		}
-		availableVersion := resp.Body()
+		ids = append(ids, ocmUtils.CreateVersionId(v, cluster.Version().ChannelGroup()))
+	}
+	versions, err := common.GetVersions(ctx, versionClient, ids)
+	if err != nil {
+		return nil, err
+	}
+	availableUpgradeVersions := []*cmv1.Version{}
+	for _, availableVersion := range versions {
 		if availableVersion.HostedControlPlaneEnabled() {
 			availableUpgradeVersions = append(availableUpgradeVersions, availableVersion)
 		}
@@ -121,43 +155,116 @@ func GetScheduledUpgrades(ctx context.Context,
 	return upgrades, nil
 }
 
-// Check the provided list of upgrades, canceling pending upgrades that are not
-// for the correct version, and returning an error if there is already an
-// upgrade in progress that is not for the desired version
+// Check the provided list of upgrades, handling the pending upgrades that are
+// not for the correct version according to the given conflict policy, and
+// returning an error if there is already an upgrade in progress that is not for
+// the desired version. Returns true if the upgrade to the desired version is
+// already pending, and the descriptions of the conflicting upgrades that were
+// kept because of the 'keep' policy.
 func CheckAndCancelUpgrades(
 	ctx context.Context,
 	client *cmv1.ClustersClient,
-	upgrades []MachinePoolUpgrade, desiredVersion *semver.Version) (bool, error) {
+	upgrades []MachinePoolUpgrade, desiredVersion *semver.Version, conflictPolicy string) (bool, []string, error) {
 	correctUpgradePending := false
+	keptConflicts := []string{}
 	tenMinFromNow := time.Now().UTC().Add(10 * time.Minute)
 
 	for _, upgrade := range upgrades {
 		tflog.Debug(ctx, fmt.Sprintf("Found existing upgrade policy to %s in state %s", upgrade.Policy.Version(), upgrade.PolicyState.Value()))
 		toVersion, err := semver.NewVersion(upgrade.Policy.Version())
 		if err != nil {
-			return false, fmt.Errorf("failed to parse upgrade version: %v", err)
+			return false, nil, fmt.Errorf("failed to parse upgrade version: %v", err)
 		}
 		switch upgrade.PolicyState.Value() {
 		case cmv1.UpgradePolicyStateValueDelayed, cmv1.UpgradePolicyStateValueStarted:
 			if !desiredVersion.Equal(toVersion) {
-				return false, fmt.Errorf("a cluster upgrade is already in progress")
+				return false, nil, fmt.Errorf("a cluster upgrade is already in progress")
 			}
 			correctUpgradePending = true
 		case cmv1.UpgradePolicyStateValuePending, cmv1.UpgradePolicyStateValueScheduled:
 			if desiredVersion.Equal(toVersion) && upgrade.Policy.NextRun().Before(tenMinFromNow) {
 				correctUpgradePending = true
-			} else {
-				// The upgrade is not one we want, so cancel it
+				continue
+			}
+			// The upgrade is not one we want
+			switch common.UpgradeConflictPolicy(conflictPolicy) {
+			case common.UpgradeConflictPolicyFail:
+				return false, nil, fmt.Errorf("%s conflicts with the requested version '%s'",
+					upgrade.Description(), desiredVersion)
+			case common.UpgradeConflictPolicyKeep:
+				tflog.Warn(ctx, fmt.Sprintf("Keeping conflicting %s", upgrade.Description()))
+				keptConflicts = append(keptConflicts, upgrade.Description())
+			default:
 				_, err := client.Cluster(upgrade.Policy.ClusterID()).
 					NodePools().NodePool(upgrade.Policy.NodePoolID()).UpgradePolicies().
 					NodePoolUpgradePolicy(upgrade.Policy.ID()).Delete().SendContext(ctx)
 				if err != nil {
-					return false, fmt.Errorf("failed to delete upgrade policy: %v", err)
+					return false, nil, fmt.Errorf("failed to delete upgrade policy: %v", err)
 				}
 			}
 		}
 	}
-	return correctUpgradePending, nil
+	return correctUpgradePending, keptConflicts, nil
+}
+
+// Get the descriptions of the pending upgrades that are not for the desired
+// version, and that CheckAndCancelUpgrades would handle as conflicts
+func GetConflictingUpgrades(upgrades []MachinePoolUpgrade, desiredVersion *semver.Version) ([]string, error) {
+	conflicts := []string{}
+	tenMinFromNow := time.Now().UTC().Add(10 * time.Minute)
+	for _, upgrade := range upgrades {
+		if upgrade.PolicyState.Value() != cmv1.UpgradePolicyStateValuePending &&
+			upgrade.PolicyState.Value() != cmv1.UpgradePolicyStateValueScheduled {
+			continue
+		}
+		toVersion, err := semver.NewVersion(upgrade.Policy.Version())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse upgrade version: %v", err)
+		}
+		if desiredVersion.Equal(toVersion) && upgrade.Policy.NextRun().Before(tenMinFromNow) {
+			continue
+		}
+		conflicts = append(conflicts, upgrade.Description())
+	}
+	return conflicts, nil
+}
+
+// Get the identifier of the upgrade to the desired version that is in progress
+// or about to start, or an empty string if there is none
+func GetPendingUpgradeID(upgrades []MachinePoolUpgrade, desiredVersion *semver.Version) string {
+	tenMinFromNow := time.Now().UTC().Add(10 * time.Minute)
+	for _, upgrade := range upgrades {
+		toVersion, err := semver.NewVersion(upgrade.Policy.Version())
+		if err != nil || !desiredVersion.Equal(toVersion) {
+			continue
+		}
+		switch upgrade.PolicyState.Value() {
+		case cmv1.UpgradePolicyStateValueDelayed, cmv1.UpgradePolicyStateValueStarted:
+			return upgrade.Policy.ID()
+		case cmv1.UpgradePolicyStateValuePending, cmv1.UpgradePolicyStateValueScheduled:
+			if upgrade.Policy.NextRun().Before(tenMinFromNow) {
+				return upgrade.Policy.ID()
+			}
+		}
+	}
+	return ""
+}
+
+// Wait for the upgrade of the given node pool upgrade policy to complete
+func WaitForUpgradeToComplete(ctx context.Context, client *cmv1.ClustersClient,
+	clusterId string, nodePoolId string, policyId string, waitTimeoutMin int64) error {
+	policyClient := client.Cluster(clusterId).NodePools().NodePool(nodePoolId).UpgradePolicies().NodePoolUpgradePolicy(policyId)
+	return common.WaitForUpgradeToComplete(ctx, policyId, waitTimeoutMin,
+		func(ctx context.Context) (*cmv1.UpgradePolicyState, error) {
+			resp, err := policyClient.Get().SendContext(ctx)
+			if resp != nil && resp.Status() == http.StatusNotFound {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, common.HandleErr(resp.Error(), err)
+			}
+			return resp.Body().State(), nil
+		})
 }
 
 func AckVersionGate(
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/machinepool/hcp/upgrade"
+)
+
+const (
+	VersionPolicyManual             = "manual"
+	VersionPolicyFollowControlPlane = "follow_control_plane"
+)
+
+var versionPolicies = []string{VersionPolicyManual, VersionPolicyFollowControlPlane}
+
+func followsControlPlane(policy types.String) bool {
+	return policy.ValueString() == VersionPolicyFollowControlPlane
+}
+
+// requestedVersion returns the version that the machine pool should run: the configured version, or
+// the version of the control plane when the pool follows it.
+func requestedVersion(plan *HcpMachinePoolState, cluster *cmv1.Cluster) types.String {
+	if followsControlPlane(plan.MachinePoolVersionPolicy) && !common.HasValue(plan.Version) &&
+		cluster != nil && cluster.Version() != nil {
+		return types.StringValue(cluster.Version().RawID())
+	}
+	return plan.Version
+}
+
+// rawVersion returns the version without the 'openshift-v' prefix, and without the channel group
+// suffix that the identifiers of the versions of non-stable channel groups have, for example
+// '4.14.5' for 'openshift-v4.14.5-candidate'.
+func rawVersion(version string, channelGroup string) string {
+	version = strings.TrimPrefix(version, rosa.VersionPrefix)
+	if channelGroup != "" {
+		version = strings.TrimSuffix(version, fmt.Sprintf("-%s", channelGroup))
+	}
+	return version
+}
+
+// sameVersion checks if the two versions are the same, ignoring the 'openshift-v' prefix.
+func sameVersion(a, b string) bool {
+	versionA, errA := semver.NewVersion(rawVersion(a, ""))
+	versionB, errB := semver.NewVersion(rawVersion(b, ""))
+	if errA != nil || errB != nil {
+		return rawVersion(a, "") == rawVersion(b, "")
+	}
+	return versionA.Equal(versionB)
+}
+
+// modifyPlanForVersionPolicy validates the version skew between the machine pool and the control
+// plane using the versions known from the last refresh, and plans an upgrade of the machine pools
+// that follow the control plane once it runs a newer version.
+func (r *HcpMachinePoolResource) modifyPlanForVersionPolicy(ctx context.Context, req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	plan := &HcpMachinePoolState{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	follows := followsControlPlane(plan.MachinePoolVersionPolicy)
+	if follows && common.HasValue(plan.Version) {
+		resp.Diagnostics.AddAttributeError(path.Root("version"), "Invalid machine pool version",
+			fmt.Sprintf("Attribute 'version' can't be set when 'machine_pool_version_policy' is '%s'",
+				VersionPolicyFollowControlPlane))
+		return
+	}
+	if req.State.Raw.IsNull() {
+		return
+	}
+	state := &HcpMachinePoolState{}
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if common.IsStringAttributeUnknownOrEmpty(state.ControlPlaneVersion) {
+		return
+	}
+
+	desiredVersion := plan.Version
+	if follows {
+		desiredVersion = state.ControlPlaneVersion
+	} else if desiredVersion.Equal(state.Version) {
+		// Only validate the versions requested by this plan
+		return
+	}
+	if common.IsStringAttributeUnknownOrEmpty(desiredVersion) {
+		return
+	}
+	if err := upgrade.ValidateVersionSkew(state.ControlPlaneVersion.ValueString(),
+		desiredVersion.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("version"), "Invalid machine pool version", err.Error())
+		return
+	}
+
+	if follows && !common.IsStringAttributeUnknownOrEmpty(state.CurrentVersion) &&
+		!sameVersion(state.CurrentVersion.ValueString(), desiredVersion.ValueString()) {
+		tflog.Info(ctx, "Machine pool follows the control plane, planning an upgrade",
+			map[string]interface{}{
+				"current_version":       state.CurrentVersion.ValueString(),
+				"control_plane_version": desiredVersion.ValueString(),
+			})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("current_version"), types.StringUnknown())...)
+	}
+}
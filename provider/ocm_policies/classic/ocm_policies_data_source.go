@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	providerCommon "github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/ocm_policies/common"
 )
 
@@ -66,6 +67,11 @@ func (s *OcmPoliciesDataSource) Schema(ctx context.Context, req datasource.Schem
 	resp.Schema = schema.Schema{
 		Description: "List of ROSA operator role policies and account role policies.",
 		Attributes: map[string]schema.Attribute{
+			"aws_partition": schema.StringAttribute{
+				Description: providerCommon.AwsPartitionDescription,
+				Optional:    true,
+				Validators:  providerCommon.AwsPartitionValidators(),
+			},
 			"operator_role_policies": schema.SingleNestedAttribute{
 				Description: "Operator role policies.",
 				Attributes: map[string]schema.Attribute{
@@ -146,6 +152,11 @@ func (s *OcmPoliciesDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	partition := providerCommon.AwsPartition
+	if providerCommon.HasValue(state.AwsPartition) {
+		partition = state.AwsPartition.ValueString()
+	}
+
 	operatorRolePolicies := OperatorRolePolicies{}
 	accountRolePolicies := AccountRolePolicies{}
 	policiesResponse.Items().Each(func(awsPolicy *cmv1.AWSSTSPolicy) bool {
@@ -153,24 +164,24 @@ func (s *OcmPoliciesDataSource) Read(ctx context.Context, req datasource.ReadReq
 		switch awsPolicy.ID() {
 		// operator roles
 		case CloudCred:
-			operatorRolePolicies.CloudCred = types.StringValue(awsPolicy.Details())
+			operatorRolePolicies.CloudCred = policyDocument(awsPolicy, partition)
 		case CloudNetwork:
-			operatorRolePolicies.CloudNetwork = types.StringValue(awsPolicy.Details())
+			operatorRolePolicies.CloudNetwork = policyDocument(awsPolicy, partition)
 		case ClusterCSI:
-			operatorRolePolicies.ClusterCSI = types.StringValue(awsPolicy.Details())
+			operatorRolePolicies.ClusterCSI = policyDocument(awsPolicy, partition)
 		case ImageRegistry:
-			operatorRolePolicies.ImageRegistry = types.StringValue(awsPolicy.Details())
+			operatorRolePolicies.ImageRegistry = policyDocument(awsPolicy, partition)
 		case IngressOperator:
-			operatorRolePolicies.IngressOperator = types.StringValue(awsPolicy.Details())
+			operatorRolePolicies.IngressOperator = policyDocument(awsPolicy, partition)
 		case SharedVpcIngressOperator:
-			operatorRolePolicies.SharedVpcIngressOperator = types.StringValue(awsPolicy.Details())
+			operatorRolePolicies.SharedVpcIngressOperator = policyDocument(awsPolicy, partition)
 		case MachineAPI:
-			operatorRolePolicies.MachineAPI = types.StringValue(awsPolicy.Details())
+			operatorRolePolicies.MachineAPI = policyDocument(awsPolicy, partition)
 		// account roles
 		case Installer:
-			accountRolePolicies.Installer = types.StringValue(awsPolicy.Details())
+			accountRolePolicies.Installer = policyDocument(awsPolicy, partition)
 		case Support:
-			accountRolePolicies.Support = types.StringValue(awsPolicy.Details())
+			accountRolePolicies.Support = policyDocument(awsPolicy, partition)
 		case "sts_support_trust_policy":
 			jitRole, err := common.ParseRhSupportRole(ctx, awsPolicy.Details())
 			if err != nil {
@@ -178,9 +189,9 @@ func (s *OcmPoliciesDataSource) Read(ctx context.Context, req datasource.ReadReq
 			}
 			accountRolePolicies.SupportRhSreRole = types.StringValue(jitRole)
 		case InstanceWorker:
-			accountRolePolicies.InstanceWorker = types.StringValue(awsPolicy.Details())
+			accountRolePolicies.InstanceWorker = policyDocument(awsPolicy, partition)
 		case InstanceControlPlane:
-			accountRolePolicies.InstanceControlPlane = types.StringValue(awsPolicy.Details())
+			accountRolePolicies.InstanceControlPlane = policyDocument(awsPolicy, partition)
 		default:
 			tflog.Debug(ctx, "This is neither operator role policy nor account role policy")
 		}
@@ -196,3 +207,8 @@ func (s *OcmPoliciesDataSource) Read(ctx context.Context, req datasource.ReadReq
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// policyDocument returns the document of the policy, with the ARNs in the given partition.
+func policyDocument(awsPolicy *cmv1.AWSSTSPolicy, partition string) types.String {
+	return types.StringValue(providerCommon.PolicyWithPartition(awsPolicy.Details(), partition))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	providerCommon "github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/ocm_policies/common"
 )
 
@@ -66,6 +67,11 @@ func (s *OcmPoliciesDataSource) Schema(ctx context.Context, req datasource.Schem
 	resp.Schema = schema.Schema{
 		Description: "List of ROSA operator role policies and account role policies.",
 		Attributes: map[string]schema.Attribute{
+			"aws_partition": schema.StringAttribute{
+				Description: providerCommon.AwsPartitionDescription,
+				Optional:    true,
+				Validators:  providerCommon.AwsPartitionValidators(),
+			},
 			"operator_role_policies": schema.SingleNestedAttribute{
 				Description: "Operator role policies.",
 				Attributes: map[string]schema.Attribute{
@@ -146,6 +152,11 @@ func (s *OcmPoliciesDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	partition := providerCommon.AwsPartition
+	if providerCommon.HasValue(state.AwsPartition) {
+		partition = state.AwsPartition.ValueString()
+	}
+
 	operatorRolePolicies := OperatorRolePolicies{}
 	accountRolePolicies := AccountRolePolicies{}
 	policiesResponse.Items().Each(func(awsPolicy *cmv1.AWSSTSPolicy) bool {
@@ -153,26 +164,26 @@ func (s *OcmPoliciesDataSource) Read(ctx context.Context, req datasource.ReadReq
 		switch awsPolicy.ID() {
 		// operator roles
 		case ImageRegistry:
-			operatorRolePolicies.ImageRegistry = types.StringValue(awsPolicy.ARN())
+			operatorRolePolicies.ImageRegistry = policyArn(awsPolicy, partition)
 		case IngressOperator:
-			operatorRolePolicies.IngressOperator = types.StringValue(awsPolicy.ARN())
+			operatorRolePolicies.IngressOperator = policyArn(awsPolicy, partition)
 		case ClusterCSI:
-			operatorRolePolicies.ClusterCSI = types.StringValue(awsPolicy.ARN())
+			operatorRolePolicies.ClusterCSI = policyArn(awsPolicy, partition)
 		case CloudNetwork:
-			operatorRolePolicies.CloudNetwork = types.StringValue(awsPolicy.ARN())
+			operatorRolePolicies.CloudNetwork = policyArn(awsPolicy, partition)
 		case KubeControllerManagerKubeSystem:
-			operatorRolePolicies.KubeControllerManagerKubeSystem = types.StringValue(awsPolicy.ARN())
+			operatorRolePolicies.KubeControllerManagerKubeSystem = policyArn(awsPolicy, partition)
 		case CapaControllerManagerKubeSystem:
-			operatorRolePolicies.CapaControllerManagerKubeSystem = types.StringValue(awsPolicy.ARN())
+			operatorRolePolicies.CapaControllerManagerKubeSystem = policyArn(awsPolicy, partition)
 		case ControlPlaneOperatorKubeSystem:
-			operatorRolePolicies.ControlPlaneOperatorKubeSystem = types.StringValue(awsPolicy.ARN())
+			operatorRolePolicies.ControlPlaneOperatorKubeSystem = policyArn(awsPolicy, partition)
 		case KmsProviderKubeSystem:
-			operatorRolePolicies.KmsProviderKubeSystem = types.StringValue(awsPolicy.ARN())
+			operatorRolePolicies.KmsProviderKubeSystem = policyArn(awsPolicy, partition)
 		// account roles
 		case Installer:
-			accountRolePolicies.Installer = types.StringValue(awsPolicy.ARN())
+			accountRolePolicies.Installer = policyArn(awsPolicy, partition)
 		case Support:
-			accountRolePolicies.Support = types.StringValue(awsPolicy.ARN())
+			accountRolePolicies.Support = policyArn(awsPolicy, partition)
 		case "sts_support_trust_policy":
 			jitRole, err := common.ParseRhSupportRole(ctx, awsPolicy.Details())
 			if err != nil {
@@ -180,7 +191,7 @@ func (s *OcmPoliciesDataSource) Read(ctx context.Context, req datasource.ReadReq
 			}
 			accountRolePolicies.SupportRhSreRole = types.StringValue(jitRole)
 		case InstanceWorker:
-			accountRolePolicies.InstanceWorker = types.StringValue(awsPolicy.ARN())
+			accountRolePolicies.InstanceWorker = policyArn(awsPolicy, partition)
 		default:
 			tflog.Debug(ctx, "This is neither operator role policy nor account role policy")
 		}
@@ -194,3 +205,8 @@ func (s *OcmPoliciesDataSource) Read(ctx context.Context, req datasource.ReadReq
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// policyArn returns the ARN of the AWS managed policy in the given partition.
+func policyArn(awsPolicy *cmv1.AWSSTSPolicy, partition string) types.String {
+	return types.StringValue(providerCommon.ArnWithPartition(awsPolicy.ARN(), partition))
+}
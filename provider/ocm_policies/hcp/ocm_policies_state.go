@@ -19,6 +19,7 @@ package hcp
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type OcmPoliciesState struct {
+	AwsPartition         types.String          `tfsdk:"aws_partition"`
 	OperatorRolePolicies *OperatorRolePolicies `tfsdk:"operator_role_policies"`
 	AccountRolePolicies  *AccountRolePolicies  `tfsdk:"account_role_policies"`
 }
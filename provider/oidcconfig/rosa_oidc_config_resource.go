@@ -29,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
 type RosaOidcConfigResource struct {
@@ -388,29 +390,21 @@ func (o *RosaOidcConfigResource) populateState(ctx context.Context, object *cmv1
 		state.SecretARN = types.StringValue(secretArn)
 	}
 
-	oidcEndpointURL := issuerUrl
-	if strings.HasPrefix(oidcEndpointURL, "https://") {
-		oidcEndpointURL = strings.TrimPrefix(oidcEndpointURL, "https://")
-	}
-	state.OIDCEndpointURL = types.StringValue(oidcEndpointURL)
+	state.OIDCEndpointURL = types.StringValue(oidcEndpointURL(issuerUrl))
 
-	input, err := cmv1.NewOidcThumbprintInput().OidcConfigId(object.ID()).Build()
-	if err != nil {
-		tflog.Error(ctx, fmt.Sprintf("cannot create oidc thumbprint input: %v", err))
-		state.Thumbprint = types.StringValue("")
-	}
-	thumbprint, err := o.awsInquiriesClient.OidcThumbprint().Post().Body(input).SendContext(ctx)
+	thumbprint, err := common.GetOidcThumbprint(ctx, o.awsInquiriesClient,
+		cmv1.NewOidcThumbprintInput().OidcConfigId(object.ID()))
 	if err != nil {
-		tflog.Error(ctx, fmt.Sprintf("cannot get thumbprint: %v", err))
+		tflog.Error(ctx, err.Error())
 		state.Thumbprint = types.StringValue("")
 		return err
-	} else if thumbprint.Body() == nil {
-		strErr := fmt.Sprintf("thumbprint body is empty, thumbprint not retrieved")
-		tflog.Error(ctx, strErr)
-		state.Thumbprint = types.StringValue("")
-		return fmt.Errorf(strErr)
-	} else {
-		state.Thumbprint = types.StringValue(thumbprint.Body().Thumbprint())
 	}
+	state.Thumbprint = types.StringValue(thumbprint)
 	return nil
 }
+
+// oidcEndpointURL returns the issuer URL without the 'https://' prefix, as expected in the
+// 'oidc_endpoint_url' attribute of the clusters.
+func oidcEndpointURL(issuerUrl string) string {
+	return strings.TrimPrefix(issuerUrl, "https://")
+}
@@ -0,0 +1,211 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidcconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type RosaOidcConfigsDataSource struct {
+	oidcConfigClient   *cmv1.OidcConfigsClient
+	awsInquiriesClient *cmv1.AWSInquiriesClient
+}
+
+var _ datasource.DataSource = &RosaOidcConfigsDataSource{}
+var _ datasource.DataSourceWithConfigure = &RosaOidcConfigsDataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &RosaOidcConfigsDataSource{}
+}
+
+func (d *RosaOidcConfigsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rosa_oidc_configs"
+}
+
+func (d *RosaOidcConfigsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of the OIDC configurations of the organization, for example to share a managed " +
+			"OIDC configuration between clusters.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Return only the OIDC configuration with this identifier.",
+				Optional:    true,
+			},
+			"managed": schema.BoolAttribute{
+				Description: "Return only the Red Hat managed OIDC configurations when true, or only the " +
+					"unmanaged (customer hosted) ones when false.",
+				Optional: true,
+			},
+			"issuer_url": schema.StringAttribute{
+				Description: "Return only the OIDC configuration with this issuer URL. The 'https://' prefix " +
+					"is optional, so the OIDC endpoint URL can also be used.",
+				Optional: true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Content of the list.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The OIDC config ID",
+							Computed:    true,
+						},
+						"managed": schema.BoolAttribute{
+							Description: "Indicates whether it is a Red Hat managed or unmanaged (Customer hosted) OIDC configuration, for the cluster's OIDC provider.",
+							Computed:    true,
+						},
+						"reusable": schema.BoolAttribute{
+							Description: "Indicates whether the OIDC configuration can be used by more than one cluster.",
+							Computed:    true,
+						},
+						"issuer_url": schema.StringAttribute{
+							Description: "The bucket/issuer URL",
+							Computed:    true,
+						},
+						"oidc_endpoint_url": schema.StringAttribute{
+							Description: "OIDC Endpoint URL",
+							Computed:    true,
+						},
+						"thumbprint": schema.StringAttribute{
+							Description: "SHA1-hash value of the root CA of the issuer URL",
+							Computed:    true,
+						},
+						"installer_role_arn": schema.StringAttribute{
+							Description: "AWS STS Role ARN for cluster install (with get-secrets permission in the attached policy)",
+							Computed:    true,
+						},
+						"secret_arn": schema.StringAttribute{
+							Description: "Indicates for unmanaged OIDC config, the secret ARN",
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *RosaOidcConfigsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection, ok := req.ProviderData.(*sdk.Connection)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.Connection, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.oidcConfigClient = connection.ClustersMgmt().V1().OidcConfigs()
+	d.awsInquiriesClient = connection.ClustersMgmt().V1().AWSInquiries()
+}
+
+func (d *RosaOidcConfigsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &RosaOidcConfigsState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The list doesn't support searching, so the filters are applied to the complete list:
+	oidcConfigs := []*cmv1.OidcConfig{}
+	listSize := 100
+	listPage := 1
+	listRequest := d.oidcConfigClient.List().Size(listSize)
+	for {
+		listResponse, err := listRequest.SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't list OIDC configs",
+				err.Error(),
+			)
+			return
+		}
+		listResponse.Items().Each(func(oidcConfig *cmv1.OidcConfig) bool {
+			if matchesOidcConfigFilters(oidcConfig, state) {
+				oidcConfigs = append(oidcConfigs, oidcConfig)
+			}
+			return true
+		})
+		if listResponse.Size() < listSize {
+			break
+		}
+		listPage++
+		listRequest.Page(listPage)
+	}
+
+	state.Items = []*RosaOidcConfigsItem{}
+	for _, oidcConfig := range oidcConfigs {
+		thumbprint, err := common.GetOidcThumbprint(ctx, d.awsInquiriesClient,
+			cmv1.NewOidcThumbprintInput().OidcConfigId(oidcConfig.ID()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't get OIDC config thumbprint",
+				fmt.Sprintf("Can't get thumbprint of OIDC config '%s': %v", oidcConfig.ID(), err),
+			)
+			return
+		}
+		state.Items = append(state.Items, &RosaOidcConfigsItem{
+			ID:               types.StringValue(oidcConfig.ID()),
+			Managed:          types.BoolValue(oidcConfig.Managed()),
+			Reusable:         types.BoolValue(oidcConfig.Reusable()),
+			IssuerUrl:        types.StringValue(oidcConfig.IssuerUrl()),
+			OIDCEndpointURL:  types.StringValue(oidcEndpointURL(oidcConfig.IssuerUrl())),
+			Thumbprint:       types.StringValue(thumbprint),
+			InstallerRoleARN: common.EmptiableStringToStringType(oidcConfig.InstallerRoleArn()),
+			SecretARN:        common.EmptiableStringToStringType(oidcConfig.SecretArn()),
+		})
+	}
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func matchesOidcConfigFilters(oidcConfig *cmv1.OidcConfig, state *RosaOidcConfigsState) bool {
+	if common.HasValue(state.ID) && oidcConfig.ID() != state.ID.ValueString() {
+		return false
+	}
+	if common.HasValue(state.Managed) && oidcConfig.Managed() != state.Managed.ValueBool() {
+		return false
+	}
+	if common.HasValue(state.IssuerUrl) {
+		expected := strings.TrimSuffix(oidcEndpointURL(state.IssuerUrl.ValueString()), "/")
+		actual := strings.TrimSuffix(oidcEndpointURL(oidcConfig.IssuerUrl()), "/")
+		if actual != expected {
+			return false
+		}
+	}
+	return true
+}
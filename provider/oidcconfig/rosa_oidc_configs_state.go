@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidcconfig
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RosaOidcConfigsState struct {
+	ID        types.String           `tfsdk:"id"`
+	Managed   types.Bool             `tfsdk:"managed"`
+	IssuerUrl types.String           `tfsdk:"issuer_url"`
+	Items     []*RosaOidcConfigsItem `tfsdk:"items"`
+}
+
+type RosaOidcConfigsItem struct {
+	ID               types.String `tfsdk:"id"`
+	Managed          types.Bool   `tfsdk:"managed"`
+	Reusable         types.Bool   `tfsdk:"reusable"`
+	IssuerUrl        types.String `tfsdk:"issuer_url"`
+	OIDCEndpointURL  types.String `tfsdk:"oidc_endpoint_url"`
+	Thumbprint       types.String `tfsdk:"thumbprint"`
+	InstallerRoleARN types.String `tfsdk:"installer_role_arn"`
+	SecretARN        types.String `tfsdk:"secret_arn"`
+}
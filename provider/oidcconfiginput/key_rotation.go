@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidcconfiginput
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+// Phases of the rotation of the private key. The rotation starts publishing the public key of the
+// next private key together with the current one, then the next private key is used to sign the
+// tokens, and finally the current public key is removed. When the rotation phase is removed after
+// that the next private key becomes the current one.
+const (
+	rotationPhasePublish  = "publish"
+	rotationPhaseActivate = "activate"
+	rotationPhaseRetire   = "retire"
+)
+
+var rotationPhases = []string{rotationPhasePublish, rotationPhaseActivate, rotationPhaseRetire}
+
+// rotationTransitions contains the phases that can follow each phase, the empty string means
+// that there is no rotation in progress. Tokens signed with a private key are always verifiable
+// with the published keys, so phases can't be skipped.
+var rotationTransitions = map[string][]string{
+	"":                    {rotationPhasePublish},
+	rotationPhasePublish:  {"", rotationPhaseActivate},
+	rotationPhaseActivate: {rotationPhasePublish, rotationPhaseRetire},
+	rotationPhaseRetire:   {""},
+}
+
+func isValidRotationTransition(from string, to string) bool {
+	for _, phase := range rotationTransitions[from] {
+		if phase == to {
+			return true
+		}
+	}
+	return false
+}
+
+func describeRotationPhase(phase string) string {
+	if phase == "" {
+		return "no rotation"
+	}
+	return fmt.Sprintf("'%s'", phase)
+}
+
+func describeRotationTransitions(from string) string {
+	phases := []string{}
+	for _, phase := range rotationTransitions[from] {
+		phases = append(phases, describeRotationPhase(phase))
+	}
+	return strings.Join(phases, " or ")
+}
+
+// populateRotation calculates the JSON web key set and the signing private key according to the
+// rotation phase, generating the next private key when needed.
+func populateRotation(state *RosaOidcConfigInputState, privateKey *rsa.PrivateKey, keySize int) error {
+	phase := state.RotationPhase.ValueString()
+	if phase == "" {
+		state.NextPrivateKey = types.StringNull()
+		state.SigningPrivateKey = state.PrivateKey
+		return nil
+	}
+
+	nextPrivateKeyPEM := ""
+	if common.HasValue(state.NextPrivateKey) {
+		nextPrivateKeyPEM = state.NextPrivateKey.ValueString()
+	} else {
+		var err error
+		nextPrivateKeyPEM, err = generatePrivateKey(keySize)
+		if err != nil {
+			return err
+		}
+	}
+	nextPrivateKey, err := parsePrivateKey(nextPrivateKeyPEM)
+	if err != nil {
+		return fmt.Errorf("invalid next private key: %v", err)
+	}
+	if nextPrivateKey.Equal(privateKey) {
+		return fmt.Errorf("the next private key must be different from the current private key")
+	}
+
+	var jwks []byte
+	signingPrivateKey := nextPrivateKeyPEM
+	switch phase {
+	case rotationPhasePublish:
+		jwks, err = buildJSONWebKeySet(privateKey, nextPrivateKey)
+		signingPrivateKey = state.PrivateKey.ValueString()
+	case rotationPhaseActivate:
+		jwks, err = buildJSONWebKeySet(nextPrivateKey, privateKey)
+	case rotationPhaseRetire:
+		jwks, err = buildJSONWebKeySet(nextPrivateKey)
+	default:
+		err = fmt.Errorf("unknown rotation phase '%s'", phase)
+	}
+	if err != nil {
+		return err
+	}
+	state.Jwks = types.StringValue(string(jwks))
+	state.NextPrivateKey = types.StringValue(nextPrivateKeyPEM)
+	state.SigningPrivateKey = types.StringValue(signingPrivateKey)
+	return nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidcconfiginput
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+
+	rosaOidcConfig "github.com/openshift-online/ocm-common/pkg/rosa/oidcconfigs"
+)
+
+const (
+	defaultKeySize            = 4096
+	prefixForPrivateKeySecret = "rosa-private-key"
+)
+
+var validKeySizes = []int64{2048, 3072, 4096}
+
+// buildOidcConfigInput calculates the OIDC config input. Only the bucket name and the private key
+// are random, and only when they aren't given, everything else is derived from them, so the same
+// inputs always produce the same discovery document and JSON web key set.
+func buildOidcConfigInput(region string, bucketName string, privateKeyPEM string,
+	keySize int) (rosaOidcConfig.OidcConfigInput, error) {
+	var err error
+	if bucketName == "" {
+		bucketName, err = rosaOidcConfig.GenerateBucketName("")
+		if err != nil {
+			return rosaOidcConfig.OidcConfigInput{}, fmt.Errorf("there was a problem generating bucket name: %v", err)
+		}
+	} else if !rosaOidcConfig.IsValidBucketName(bucketName) {
+		return rosaOidcConfig.OidcConfigInput{}, fmt.Errorf("the bucket name '%s' is not valid", bucketName)
+	}
+
+	if privateKeyPEM == "" {
+		privateKeyPEM, err = generatePrivateKey(keySize)
+		if err != nil {
+			return rosaOidcConfig.OidcConfigInput{}, err
+		}
+	}
+	privateKey, err := parsePrivateKey(privateKeyPEM)
+	if err != nil {
+		return rosaOidcConfig.OidcConfigInput{}, err
+	}
+	jwks, err := buildJSONWebKeySet(privateKey)
+	if err != nil {
+		return rosaOidcConfig.OidcConfigInput{}, err
+	}
+
+	input := buildOidcConfigNames(region, bucketName)
+	input.PrivateKey = []byte(privateKeyPEM)
+	input.Jwks = jwks
+	return input, nil
+}
+
+// buildOidcConfigNames calculates the parts of the OIDC config input that don't depend on the
+// private key.
+func buildOidcConfigNames(region string, bucketName string) rosaOidcConfig.OidcConfigInput {
+	issuerUrl := fmt.Sprintf("https://%s.s3.%s.amazonaws.com", bucketName, region)
+	privateKeySecretName := fmt.Sprintf("%s-%s", prefixForPrivateKeySecret, bucketName)
+	return rosaOidcConfig.OidcConfigInput{
+		BucketName:           bucketName,
+		IssuerUrl:            issuerUrl,
+		PrivateKeyFilename:   fmt.Sprintf("%s.key", privateKeySecretName),
+		DiscoveryDocument:    rosaOidcConfig.GenerateDiscoveryDocument(issuerUrl),
+		PrivateKeySecretName: privateKeySecretName,
+	}
+}
+
+// generatePrivateKey generates a new RSA private key and returns it in PKCS #1 PEM format.
+func generatePrivateKey(keySize int) (string, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate private key: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})), nil
+}
+
+// buildJSONWebKeySet builds the JSON web key set containing the public keys of the given private
+// keys, in the same order.
+func buildJSONWebKeySet(privateKeys ...*rsa.PrivateKey) ([]byte, error) {
+	keySet := rosaOidcConfig.JSONWebKeySet{}
+	for _, privateKey := range privateKeys {
+		publicKeyBytes, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate public key from private: %v", err)
+		}
+		jwks, err := rosaOidcConfig.BuildJSONWebKeySet(pem.EncodeToMemory(&pem.Block{
+			Type:  "PUBLIC KEY",
+			Bytes: publicKeyBytes,
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("there was a problem generating JSON Web Key Set: %v", err)
+		}
+		keys := rosaOidcConfig.JSONWebKeySet{}
+		err = json.Unmarshal(jwks, &keys)
+		if err != nil {
+			return nil, fmt.Errorf("there was a problem parsing JSON Web Key Set: %v", err)
+		}
+		keySet.Keys = append(keySet.Keys, keys.Keys...)
+	}
+	result, err := json.MarshalIndent(keySet, "", "    ")
+	if err != nil {
+		return nil, fmt.Errorf("JSON encoding of web key set failed: %v", err)
+	}
+	return result, nil
+}
+
+// parsePrivateKey parses an RSA private key in PKCS #1 or PKCS #8 PEM format.
+func parsePrivateKey(privateKeyPEM string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode the private key, it must be in PEM format")
+	}
+	if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return privateKey, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the private key: %v", err)
+	}
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the private key must be an RSA key, but it is of type %T", key)
+	}
+	return privateKey, nil
+}
@@ -18,11 +18,21 @@ package oidcconfiginput
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	rosaOidcConfig "github.com/openshift-online/ocm-common/pkg/rosa/oidcconfigs"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
 type RosaOidcConfigInputResource struct {
@@ -30,6 +40,7 @@ type RosaOidcConfigInputResource struct {
 
 var _ resource.ResourceWithConfigure = &RosaOidcConfigInputResource{}
 var _ resource.ResourceWithImportState = &RosaOidcConfigInputResource{}
+var _ resource.ResourceWithModifyPlan = &RosaOidcConfigInputResource{}
 
 func New() resource.Resource {
 	return &RosaOidcConfigInputResource{}
@@ -42,15 +53,29 @@ func (o *RosaOidcConfigInputResource) Metadata(ctx context.Context, req resource
 func (o *RosaOidcConfigInputResource) Schema(ctx context.Context, request resource.SchemaRequest,
 	response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
-		Description: "OIDC config input resources' names",
+		Description: "OIDC config input resources' names. The discovery document and the JSON web key set " +
+			"are derived from the bucket name and the private key, so when both are given the resource can " +
+			"be replaced or imported without rotating the keys.",
 		Attributes: map[string]schema.Attribute{
 			"region": schema.StringAttribute{
-				Description: "Unique identifier of the cluster.",
+				Description: "Unique identifier of the cluster." + common.ValueCannotBeChangedStringDescription,
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"bucket_name": schema.StringAttribute{
-				Description: "The S3 bucket name",
-				Computed:    true,
+				Description: "The S3 bucket name. If not given a random name is generated." +
+					common.ValueCannotBeChangedStringDescription,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(knownStateRequiresReplace, "", ""),
+				},
 			},
 			"discovery_doc": schema.StringAttribute{
 				Description: "The discovery document string file",
@@ -61,9 +86,64 @@ func (o *RosaOidcConfigInputResource) Schema(ctx context.Context, request resour
 				Computed:    true,
 			},
 			"private_key": schema.StringAttribute{
-				Description: "RSA private key",
-				Computed:    true,
-				Sensitive:   true,
+				Description: "RSA private key, in PKCS #1 or PKCS #8 PEM format. If not given a new key of " +
+					"'key_size' bits is generated. It can only be changed, without replacing the resource, " +
+					"to the value of 'next_private_key' when completing a rotation.",
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(privateKeyRequiresReplace, "", ""),
+				},
+			},
+			"next_private_key": schema.StringAttribute{
+				Description: "RSA private key that replaces 'private_key' during a rotation, in PKCS #1 or " +
+					"PKCS #8 PEM format. If not given a new key of 'key_size' bits is generated when the " +
+					"rotation starts.",
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("rotation_phase")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_phase": schema.StringAttribute{
+				Description: fmt.Sprintf("Phase of the rotation of the private key, one of %s. In the '%s' "+
+					"phase the JSON web key set contains the current and the next keys, and tokens are still "+
+					"signed with the current key. In the '%s' phase tokens are signed with the next key. In "+
+					"the '%s' phase the current key is removed from the JSON web key set. Removing the "+
+					"rotation phase after that completes the rotation, and the next key becomes the "+
+					"private key. Phases can't be skipped.",
+					strings.Join(rotationPhases, ", "), rotationPhasePublish, rotationPhaseActivate,
+					rotationPhaseRetire),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(rotationPhases...),
+				},
+			},
+			"signing_private_key": schema.StringAttribute{
+				Description: "RSA private key that should be used to sign the tokens, and stored in the " +
+					"'private_key_secret_name' secret. It is 'private_key' unless a rotation is in progress.",
+				Computed:  true,
+				Sensitive: true,
+			},
+			"key_size": schema.Int64Attribute{
+				Description: fmt.Sprintf("Size in bits of the RSA private key, one of %s. Defaults to %d, or "+
+					"to the size of 'private_key' when it is given.", joinKeySizes(), defaultKeySize) +
+					common.ValueCannotBeChangedStringDescription,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(validKeySizes...),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplaceIf(knownInt64StateRequiresReplace, "", ""),
+				},
 			},
 			"private_key_file_name": schema.StringAttribute{
 				Description: "The private key file name",
@@ -82,10 +162,109 @@ func (o *RosaOidcConfigInputResource) Schema(ctx context.Context, request resour
 	return
 }
 
+// knownStateRequiresReplace requires replacement only when the value in the state is known. It
+// isn't known after importing, and then the value from the configuration is just stored.
+func knownStateRequiresReplace(ctx context.Context, req planmodifier.StringRequest,
+	resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// privateKeyRequiresReplace is like knownStateRequiresReplace, but changing the private key to the
+// next private key completes a rotation instead of replacing the resource.
+func privateKeyRequiresReplace(ctx context.Context, req planmodifier.StringRequest,
+	resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var nextPrivateKey types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("next_private_key"), &nextPrivateKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.Equal(nextPrivateKey)
+}
+
+func knownInt64StateRequiresReplace(ctx context.Context, req planmodifier.Int64Request,
+	resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+func joinKeySizes() string {
+	sizes := []string{}
+	for _, size := range validKeySizes {
+		sizes = append(sizes, fmt.Sprintf("%d", size))
+	}
+	return strings.Join(sizes, ", ")
+}
+
 func (o *RosaOidcConfigInputResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Do nothing
 }
 
+func (o *RosaOidcConfigInputResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed:
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state, config RosaOidcConfigInputState
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	}
+	if response.Diagnostics.HasError() || plan.RotationPhase.IsUnknown() {
+		return
+	}
+
+	from := state.RotationPhase.ValueString()
+	to := plan.RotationPhase.ValueString()
+	if from == to {
+		return
+	}
+	if !isValidRotationTransition(from, to) {
+		response.Diagnostics.AddAttributeError(
+			path.Root("rotation_phase"),
+			"Invalid rotation phase",
+			fmt.Sprintf(
+				"The rotation phase can't change from %s to %s, it can only change to %s",
+				describeRotationPhase(from), describeRotationPhase(to), describeRotationTransitions(from),
+			),
+		)
+		return
+	}
+	if (from == rotationPhaseActivate || from == rotationPhaseRetire) && common.HasValue(plan.NextPrivateKey) &&
+		!plan.NextPrivateKey.Equal(state.NextPrivateKey) {
+		response.Diagnostics.AddAttributeError(
+			path.Root("next_private_key"),
+			"Invalid next private key",
+			"The next private key can't be changed once it is used to sign tokens",
+		)
+		return
+	}
+
+	switch {
+	case from == rotationPhaseRetire && to == "":
+		// Completing the rotation, the next private key becomes the private key:
+		if !config.PrivateKey.IsNull() && !config.PrivateKey.Equal(state.NextPrivateKey) {
+			response.Diagnostics.AddAttributeError(
+				path.Root("private_key"),
+				"Invalid private key",
+				"To complete the rotation the private key must be the value of 'next_private_key'",
+			)
+			return
+		}
+		plan.PrivateKey = state.NextPrivateKey
+		plan.NextPrivateKey = types.StringNull()
+		if config.KeySize.IsNull() {
+			plan.KeySize = types.Int64Unknown()
+		}
+	case to == "":
+		// Cancelling the rotation before the next private key is used:
+		plan.NextPrivateKey = types.StringNull()
+	default:
+		return
+	}
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
 func (o *RosaOidcConfigInputResource) Create(ctx context.Context, request resource.CreateRequest,
 	response *resource.CreateResponse) {
 	// Get the plan:
@@ -96,8 +275,7 @@ func (o *RosaOidcConfigInputResource) Create(ctx context.Context, request resour
 		return
 	}
 
-	region := state.Region.ValueString()
-	oidcConfigInput, err := rosaOidcConfig.BuildOidcConfigInput("", region)
+	err := populateState(&state)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Cannot generate oidc config input object",
@@ -108,15 +286,6 @@ func (o *RosaOidcConfigInputResource) Create(ctx context.Context, request resour
 		)
 		return
 	}
-	state.BucketName = types.StringValue(oidcConfigInput.BucketName)
-	state.IssuerUrl = types.StringValue(oidcConfigInput.IssuerUrl)
-	state.PrivateKey = types.StringValue(string(oidcConfigInput.PrivateKey[:]))
-	state.PrivateKeyFileName = types.StringValue(oidcConfigInput.PrivateKeyFilename)
-	state.DiscoveryDoc = types.StringValue(oidcConfigInput.DiscoveryDocument)
-
-	state.Jwks = types.StringValue(string(oidcConfigInput.Jwks[:]))
-
-	state.PrivateKeySecretName = types.StringValue(oidcConfigInput.PrivateKeySecretName)
 
 	diags = response.State.Set(ctx, state)
 	response.Diagnostics.Append(diags...)
@@ -129,13 +298,36 @@ func (o *RosaOidcConfigInputResource) Read(ctx context.Context, request resource
 
 func (o *RosaOidcConfigInputResource) Update(ctx context.Context, request resource.UpdateRequest,
 	response *resource.UpdateResponse) {
-	response.Diagnostics.AddError(
-		"Update method is not supported for that resource",
-		fmt.Sprintf(
-			"Update method is not supported for that resource",
-		),
-	)
-	return
+	// Changes require replacement unless the resource was imported, and then the private key
+	// given in the configuration completes the state:
+	var state RosaOidcConfigInputState
+	diags := request.Plan.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if !common.HasValue(state.PrivateKey) {
+		response.Diagnostics.AddError(
+			"Cannot update oidc config input object",
+			"The private key of an imported oidc config input object must be given in the 'private_key' attribute",
+		)
+		return
+	}
+
+	err := populateState(&state)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Cannot update oidc config input object",
+			fmt.Sprintf(
+				"Cannot update oidc config input object: %v",
+				err,
+			),
+		)
+		return
+	}
+
+	diags = response.State.Set(ctx, state)
+	response.Diagnostics.Append(diags...)
 }
 
 func (o *RosaOidcConfigInputResource) Delete(ctx context.Context, request resource.DeleteRequest,
@@ -145,6 +337,83 @@ func (o *RosaOidcConfigInputResource) Delete(ctx context.Context, request resour
 
 func (o *RosaOidcConfigInputResource) ImportState(ctx context.Context, request resource.ImportStateRequest,
 	response *resource.ImportStateResponse) {
-	// Do Nothing
+	// The private key can't be part of the identifier, it is taken from the configuration in the
+	// next apply:
+	fields := strings.Split(request.ID, ",")
+	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		response.Diagnostics.AddError(
+			"Invalid import identifier",
+			"OIDC config input to import should be specified as <region>,<bucket_name>",
+		)
+		return
+	}
+	region := fields[0]
+	bucketName := fields[1]
+	if !rosaOidcConfig.IsValidBucketName(bucketName) {
+		response.Diagnostics.AddError(
+			"Invalid import identifier",
+			fmt.Sprintf("The bucket name '%s' is not valid", bucketName),
+		)
+		return
+	}
 
+	input := buildOidcConfigNames(region, bucketName)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("region"), region)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("bucket_name"), input.BucketName)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("issuer_url"), input.IssuerUrl)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("discovery_doc"), input.DiscoveryDocument)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("private_key_file_name"), input.PrivateKeyFilename)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("private_key_secret_name"), input.PrivateKeySecretName)...)
+}
+
+// populateState calculates the computed attributes from the region, bucket name, private key and
+// key size in the state.
+func populateState(state *RosaOidcConfigInputState) error {
+	privateKey := ""
+	if common.HasValue(state.PrivateKey) {
+		privateKey = state.PrivateKey.ValueString()
+	}
+	keySize := int64(defaultKeySize)
+	if common.HasValue(state.KeySize) {
+		keySize = state.KeySize.ValueInt64()
+	}
+	if privateKey != "" {
+		parsed, err := parsePrivateKey(privateKey)
+		if err != nil {
+			return err
+		}
+		size := int64(parsed.N.BitLen())
+		if common.HasValue(state.KeySize) && size != keySize {
+			return fmt.Errorf("the size of the private key is %d bits, but 'key_size' is %d", size, keySize)
+		}
+		if size < validKeySizes[0] {
+			return fmt.Errorf("the size of the private key is %d bits, it must be at least %d", size, validKeySizes[0])
+		}
+		keySize = size
+	}
+	bucketName := ""
+	if common.HasValue(state.BucketName) {
+		bucketName = state.BucketName.ValueString()
+	}
+
+	oidcConfigInput, err := buildOidcConfigInput(state.Region.ValueString(), bucketName, privateKey, int(keySize))
+	if err != nil {
+		return err
+	}
+	state.BucketName = types.StringValue(oidcConfigInput.BucketName)
+	state.IssuerUrl = types.StringValue(oidcConfigInput.IssuerUrl)
+	state.PrivateKey = types.StringValue(string(oidcConfigInput.PrivateKey[:]))
+	state.KeySize = types.Int64Value(keySize)
+	state.PrivateKeyFileName = types.StringValue(oidcConfigInput.PrivateKeyFilename)
+	state.DiscoveryDoc = types.StringValue(oidcConfigInput.DiscoveryDocument)
+
+	state.Jwks = types.StringValue(string(oidcConfigInput.Jwks[:]))
+
+	state.PrivateKeySecretName = types.StringValue(oidcConfigInput.PrivateKeySecretName)
+
+	parsed, err := parsePrivateKey(state.PrivateKey.ValueString())
+	if err != nil {
+		return err
+	}
+	return populateRotation(state, parsed, int(keySize))
 }
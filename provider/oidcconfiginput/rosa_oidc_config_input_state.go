@@ -24,6 +24,10 @@ type RosaOidcConfigInputState struct {
 	DiscoveryDoc         types.String `tfsdk:"discovery_doc"`
 	Jwks                 types.String `tfsdk:"jwks"`
 	PrivateKey           types.String `tfsdk:"private_key"`
+	KeySize              types.Int64  `tfsdk:"key_size"`
+	NextPrivateKey       types.String `tfsdk:"next_private_key"`
+	SigningPrivateKey    types.String `tfsdk:"signing_private_key"`
+	RotationPhase        types.String `tfsdk:"rotation_phase"`
 	PrivateKeyFileName   types.String `tfsdk:"private_key_file_name"`
 	PrivateKeySecretName types.String `tfsdk:"private_key_secret_name"`
 	IssuerUrl            types.String `tfsdk:"issuer_url"`
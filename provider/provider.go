@@ -20,7 +20,10 @@ import (
 	"context"
 	"crypto/x509"
 	"fmt"
+	"net/url"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	tfprovider "github.com/hashicorp/terraform-plugin-framework/provider"
@@ -31,13 +34,17 @@ import (
 
 	"github.com/terraform-redhat/terraform-provider-rhcs/build"
 	"github.com/terraform-redhat/terraform-provider-rhcs/logging"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/addon"
 	classicAutoscaler "github.com/terraform-redhat/terraform-provider-rhcs/provider/autoscaler/classic"
 	hcpAutoscaler "github.com/terraform-redhat/terraform-provider-rhcs/provider/autoscaler/hcp"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/availableupgrades"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/cloudprovider"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/cluster"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/classic"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/hcp"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusters"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterwaiter"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 	defaultingress "github.com/terraform-redhat/terraform-provider-rhcs/provider/defaultingress/classic"
 	hcpingress "github.com/terraform-redhat/terraform-provider-rhcs/provider/defaultingress/hcp"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/dnsdomain"
@@ -53,10 +60,14 @@ import (
 	hcpStsPolicies "github.com/terraform-redhat/terraform-provider-rhcs/provider/ocm_policies/hcp"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/oidcconfig"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/oidcconfiginput"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/rolelink"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/rosa_account_roles"
 	classicOperatorRoles "github.com/terraform-redhat/terraform-provider-rhcs/provider/rosa_operator_roles/classic"
 	hcpOperatorRoles "github.com/terraform-redhat/terraform-provider-rhcs/provider/rosa_operator_roles/hcp"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/trusted_ip_addresses"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/tuningconfigs"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/upgradepolicy"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/versiongates"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/versions"
 )
 
@@ -67,13 +78,19 @@ var _ tfprovider.Provider = &Provider{}
 
 // Config contains the configuration of the provider.
 type Config struct {
-	URL          types.String `tfsdk:"url"`
-	TokenURL     types.String `tfsdk:"token_url"`
-	Token        types.String `tfsdk:"token"`
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	TrustedCAs   types.String `tfsdk:"trusted_cas"`
-	Insecure     types.Bool   `tfsdk:"insecure"`
+	URL            types.String `tfsdk:"url"`
+	TokenURL       types.String `tfsdk:"token_url"`
+	Token          types.String `tfsdk:"token"`
+	ClientID       types.String `tfsdk:"client_id"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+	TrustedCAs     types.String `tfsdk:"trusted_cas"`
+	Insecure       types.Bool   `tfsdk:"insecure"`
+	FedRAMP        types.Bool   `tfsdk:"fedramp"`
+	ProxyURL       types.String `tfsdk:"proxy_url"`
+	NoProxy        types.String `tfsdk:"no_proxy"`
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+	ConfigFile     types.String `tfsdk:"config_file"`
+	Profile        types.String `tfsdk:"profile"`
 }
 
 // New creates the provider.
@@ -91,12 +108,18 @@ func (p *Provider) Schema(ctx context.Context, req tfprovider.SchemaRequest, res
 	resp.Schema = tfpschema.Schema{
 		Attributes: map[string]tfpschema.Attribute{
 			"url": tfpschema.StringAttribute{
-				Description: fmt.Sprintf("URL sets the base URL of the API gateway. The default is `%s`", sdk.DefaultURL),
-				Optional:    true,
+				Description: fmt.Sprintf("URL sets the base URL of the API gateway. The default is `%s`. "+
+					"The %s aliases can be used instead of the URLs of the environments. "+
+					"When 'fedramp' is enabled the default is `%s`, and the aliases refer to the FedRAMP environments.",
+					sdk.DefaultURL, urlAliasesDescription(urlAliases), fedRAMPURLAliases[environmentProduction]),
+				Optional: true,
 			},
 			"token_url": tfpschema.StringAttribute{
-				Description: fmt.Sprintf("TokenURL returns the URL that the connection is using request OpenID access tokens. The default value is '%s'", sdk.DefaultTokenURL),
-				Optional:    true,
+				Description: fmt.Sprintf("TokenURL returns the URL that the connection is using request OpenID access tokens. The default value is '%s'. "+
+					"The %s aliases can be used instead of the URLs of the environments. "+
+					"When 'fedramp' is enabled the default is '%s', and the aliases refer to the FedRAMP environments.",
+					sdk.DefaultTokenURL, urlAliasesDescription(tokenURLAliases), fedRAMPTokenURLAliases[environmentProduction]),
+				Optional: true,
 			},
 			"token": tfpschema.StringAttribute{
 				Description: "Access or refresh token that is " +
@@ -127,6 +150,50 @@ func (p *Provider) Schema(ctx context.Context, req tfprovider.SchemaRequest, res
 					"for production environments.",
 				Optional: true,
 			},
+			"fedramp": tfpschema.BoolAttribute{
+				Description: "When set to 'true' the provider connects to the FedRAMP environments of OCM, " +
+					"used for clusters in the AWS GovCloud partition. It can also be enabled with the " +
+					"'RHCS_FEDRAMP' environment variable.",
+				Optional: true,
+			},
+			"proxy_url": tfpschema.StringAttribute{
+				Description: "URL of the proxy used for all the requests sent by the provider, for example " +
+					"`http://proxy.example.com:3128`. If this is not explicitly specified, then the value of " +
+					"the 'RHCS_PROXY_URL' environment variable is used, and if that isn't set either the " +
+					"proxy is taken from the 'HTTPS_PROXY' and 'HTTP_PROXY' environment variables.",
+				Optional: true,
+			},
+			"no_proxy": tfpschema.StringAttribute{
+				Description: "Comma separated list of host names, domain names and IP ranges that are accessed " +
+					"without the proxy, with the same format as the 'NO_PROXY' environment variable. If this " +
+					"is not explicitly specified, then the value of the 'RHCS_NO_PROXY' environment variable " +
+					"is used, and if that isn't set either the value of 'NO_PROXY'.",
+				Optional: true,
+			},
+			"request_timeout": tfpschema.StringAttribute{
+				Description: "Maximum time that each request sent by the provider can take, including reading " +
+					"the response, as a duration such as `30s` or `2m`. It can also be set with the " +
+					"'RHCS_REQUEST_TIMEOUT' environment variable. By default the requests have no time limit.",
+				Optional: true,
+			},
+			"config_file": tfpschema.StringAttribute{
+				Description: "Path of the configuration file written by the 'ocm login' and 'rosa login' commands, " +
+					"used for the URLs, client and tokens that aren't set in the provider configuration or in " +
+					"the environment. Its tokens and scopes are ignored when 'token', 'client_id' or " +
+					"'client_secret' are set. The default is the value of the 'OCM_CONFIG' environment " +
+					"variable, or '~/.ocm.json' if it exists, or '~/.config/ocm/ocm.json'. The file is only read " +
+					"when this attribute, 'profile', or the 'RHCS_CONFIG_FILE' or 'RHCS_PROFILE' environment " +
+					"variables are set.",
+				Optional: true,
+			},
+			"profile": tfpschema.StringAttribute{
+				Description: "Name of the profile of the configuration file to use, from its 'profiles' object. " +
+					"The 'ocm' and 'rosa' commands don't write profiles, so that object has to be added to the " +
+					"file by hand. " +
+					"If this is not explicitly specified, then the value of the 'RHCS_PROFILE' environment " +
+					"variable is used, and if that isn't set either the top level settings of the file.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -141,6 +208,49 @@ func (p *Provider) getAttrValueOrConfig(attr types.String, envSuffix string) (st
 	return "", false
 }
 
+// isFedRAMP checks if the FedRAMP environments are enabled, either in the configuration or with
+// the 'RHCS_FEDRAMP' environment variable. When neither is set the default value is returned.
+func (p *Provider) isFedRAMP(attr types.Bool, defaultValue bool) (bool, error) {
+	if !attr.IsNull() {
+		return attr.ValueBool(), nil
+	}
+	value, ok := os.LookupEnv("RHCS_FEDRAMP")
+	if !ok || value == "" {
+		return defaultValue, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+// loadConfigFile loads the configuration file of the CLI tools when the 'config_file' or 'profile'
+// attributes, or their environment variables, are set. Otherwise it returns an empty
+// configuration.
+func (p *Provider) loadConfigFile(fileAttr, profileAttr types.String) (*ocmConfig, error) {
+	path, pathExists := p.getAttrValueOrConfig(fileAttr, "CONFIG_FILE")
+	profile, profileExists := p.getAttrValueOrConfig(profileAttr, "PROFILE")
+	if !pathExists && !profileExists {
+		return &ocmConfig{}, nil
+	}
+	return loadOcmConfig(path, profile)
+}
+
+// validateProxyURL checks that the proxy URL is absolute and uses one of the schemes supported by
+// the Go HTTP client.
+func validateProxyURL(value string) error {
+	proxyURL, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf("the scheme of '%s' should be 'http', 'https' or 'socks5'", value)
+	}
+	if proxyURL.Host == "" {
+		return fmt.Errorf("'%s' doesn't contain a host name", value)
+	}
+	return nil
+}
+
 // configure is the configuration function of the provider. It is responsible for checking the
 // connection parameters and creating the connection that will be used by the resources.
 func (p *Provider) Configure(ctx context.Context, req tfprovider.ConfigureRequest,
@@ -163,18 +273,66 @@ func (p *Provider) Configure(ctx context.Context, req tfprovider.ConfigureReques
 	builder.Logger(logger)
 	builder.Agent(fmt.Sprintf("OCM-TF/%s-%s", build.Version, build.Commit))
 
-	// Copy the settings:
-	if url, ok := p.getAttrValueOrConfig(config.URL, "URL"); ok {
-		builder.URL(url)
+	// Copy the settings, the ones from the configuration file only when they aren't set in the
+	// provider configuration or in the environment:
+	fileConfig, err := p.loadConfigFile(config.ConfigFile, config.Profile)
+	if err != nil {
+		resp.Diagnostics.AddError("the configuration file isn't valid", err.Error())
+		return
 	}
-	if tokenURL, ok := p.getAttrValueOrConfig(config.TokenURL, "TOKEN_URL"); ok {
-		builder.TokenURL(tokenURL)
+	fedRAMP, err := p.isFedRAMP(config.FedRAMP, fileConfig.FedRAMP)
+	if err != nil {
+		resp.Diagnostics.AddError("the value of 'fedramp' isn't valid", err.Error())
+		return
 	}
-	if token, ok := p.getAttrValueOrConfig(config.Token, "TOKEN"); ok {
-		builder.Tokens(token)
+	url, urlExists := p.getAttrValueOrConfig(config.URL, "URL")
+	if !urlExists && fileConfig.URL != "" {
+		url, urlExists = fileConfig.URL, true
 	}
+	tokenURL, tokenURLExists := p.getAttrValueOrConfig(config.TokenURL, "TOKEN_URL")
+	if !tokenURLExists && fileConfig.TokenURL != "" {
+		tokenURL, tokenURLExists = fileConfig.TokenURL, true
+	}
+	currentURLAliases, currentTokenURLAliases := urlAliases, tokenURLAliases
+	if fedRAMP {
+		if !urlExists {
+			url, urlExists = environmentProduction, true
+		}
+		if !tokenURLExists {
+			tokenURL, tokenURLExists = environmentProduction, true
+		}
+		currentURLAliases, currentTokenURLAliases = fedRAMPURLAliases, fedRAMPTokenURLAliases
+	}
+	if urlExists {
+		builder.URL(resolveURLAlias(url, currentURLAliases))
+	}
+	if tokenURLExists {
+		builder.TokenURL(resolveURLAlias(tokenURL, currentTokenURLAliases))
+	}
+	token, tokenExists := p.getAttrValueOrConfig(config.Token, "TOKEN")
 	clientID, clientIdExists := p.getAttrValueOrConfig(config.ClientID, "CLIENT_ID")
 	clientSecret, clientSecretExists := p.getAttrValueOrConfig(config.ClientSecret, "CLIENT_SECRET")
+	if tokenExists {
+		builder.Tokens(token)
+	} else {
+		// The tokens and scopes of the configuration file belong to its client, so they are only
+		// used when no credentials are set explicitly. The client credentials are filled in
+		// individually, and the secret only for the client of the file:
+		if !clientIdExists && !clientSecretExists {
+			if tokens := fileConfig.Tokens(); len(tokens) > 0 {
+				builder.Tokens(tokens...)
+			}
+			if len(fileConfig.Scopes) > 0 {
+				builder.Scopes(fileConfig.Scopes...)
+			}
+		}
+		if !clientIdExists && fileConfig.ClientID != "" {
+			clientID, clientIdExists = fileConfig.ClientID, true
+		}
+		if !clientSecretExists && fileConfig.ClientID != "" && clientID == fileConfig.ClientID {
+			clientSecret, clientSecretExists = fileConfig.ClientSecret, true
+		}
+	}
 	if clientIdExists && clientSecretExists {
 		builder.Client(clientID, clientSecret)
 	}
@@ -189,9 +347,36 @@ func (p *Provider) Configure(ctx context.Context, req tfprovider.ConfigureReques
 		}
 		builder.TrustedCAs(pool)
 	}
+	insecure := fileConfig.Insecure
 	if !config.Insecure.IsNull() {
-		builder.Insecure(config.Insecure.ValueBool())
+		insecure = config.Insecure.ValueBool()
+	}
+	if insecure {
+		builder.Insecure(insecure)
+	}
+	httpSettings := common.HttpSettings{}
+	if proxyURL, ok := p.getAttrValueOrConfig(config.ProxyURL, "PROXY_URL"); ok && proxyURL != "" {
+		if err := validateProxyURL(proxyURL); err != nil {
+			resp.Diagnostics.AddError("the value of 'proxy_url' isn't valid", err.Error())
+			return
+		}
+		httpSettings.ProxyURL = proxyURL
+	}
+	if noProxy, ok := p.getAttrValueOrConfig(config.NoProxy, "NO_PROXY"); ok {
+		httpSettings.NoProxy = noProxy
+	}
+	if requestTimeout, ok := p.getAttrValueOrConfig(config.RequestTimeout, "REQUEST_TIMEOUT"); ok && requestTimeout != "" {
+		timeout, err := time.ParseDuration(requestTimeout)
+		if err == nil && timeout <= 0 {
+			err = fmt.Errorf("the timeout must be greater than zero, but it is '%s'", requestTimeout)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("the value of 'request_timeout' isn't valid", err.Error())
+			return
+		}
+		httpSettings.Timeout = timeout
 	}
+	builder.TransportWrapper(httpSettings.TransportWrapper())
 
 	// Create the connection:
 	connection, err := builder.BuildContext(ctx)
@@ -225,6 +410,11 @@ func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 		hcpingress.New,
 		tuningconfigs.New,
 		hcpAutoscaler.New,
+		upgradepolicy.New,
+		versiongates.NewAgreementResource,
+		addon.NewClusterAddonResource,
+		rolelink.NewOcmRoleLinkResource,
+		rolelink.NewUserRoleLinkResource,
 	}
 }
 
@@ -241,8 +431,18 @@ func (p *Provider) DataSources(ctx context.Context) []func() datasource.DataSour
 		machinepool.NewDatasource,
 		hcp.NewDataSource,
 		nodepool.NewDatasource,
+		machinepool.NewMachinePoolsDatasource,
+		nodepool.NewMachinePoolsDatasource,
+		clusters.New,
+		availableupgrades.NewClusterDataSource,
+		availableupgrades.NewMachinePoolDataSource,
 		hcpOperatorRoles.New,
 		hcpStsPolicies.New,
 		trusted_ip_addresses.New,
+		versiongates.NewDataSource,
+		addon.NewAddonsDataSource,
+		rosa_account_roles.New,
+		rosa_account_roles.NewHcp,
+		oidcconfig.NewDataSource,
 	}
 }
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
+	. "github.com/onsi/gomega"             // nolint
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	sdktesting "github.com/openshift-online/ocm-sdk-go/testing"
+)
+
+var _ = Describe("Provider configuration", func() {
+	var (
+		ctx         context.Context
+		configFile  string
+		fileAccess  string
+		fileRefresh string
+	)
+
+	// configure calls the Configure method of the provider with the given string attributes, and
+	// returns the connection that it creates:
+	configure := func(attrs map[string]string) (*sdk.Connection, diag.Diagnostics) {
+		provider := &Provider{}
+		schemaResp := &tfprovider.SchemaResponse{}
+		provider.Schema(ctx, tfprovider.SchemaRequest{}, schemaResp)
+		Expect(schemaResp.Diagnostics.HasError()).To(BeFalse())
+		configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range configType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		for name, value := range attrs {
+			values[name] = tftypes.NewValue(tftypes.String, value)
+		}
+		req := tfprovider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(configType, values),
+			},
+		}
+		resp := &tfprovider.ConfigureResponse{}
+		provider.Configure(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return nil, resp.Diagnostics
+		}
+		connection := resp.ResourceData.(*sdk.Connection)
+		DeferCleanup(connection.Close)
+		return connection, resp.Diagnostics
+	}
+
+	// tokenServer starts a token server that issues an access token for the given client
+	// credentials:
+	tokenServer := func(clientID, clientSecret, accessToken string) string {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.ParseForm()).To(Succeed())
+			Expect(r.Form.Get("grant_type")).To(Equal("client_credentials"))
+			id, secret, ok := r.BasicAuth()
+			Expect(ok).To(BeTrue())
+			Expect(id).To(Equal(clientID))
+			Expect(secret).To(Equal(clientSecret))
+			w.Header().Set("Content-Type", "application/json")
+			Expect(json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": accessToken,
+				"token_type":   "Bearer",
+				"expires_in":   600,
+			})).To(Succeed())
+		}))
+		DeferCleanup(server.Close)
+		return server.URL
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		// Make sure that the settings only come from the configuration used by each test:
+		for _, name := range []string{"RHCS_URL", "RHCS_TOKEN_URL", "RHCS_TOKEN", "RHCS_CLIENT_ID",
+			"RHCS_CLIENT_SECRET", "RHCS_CONFIG_FILE", "RHCS_PROFILE", "RHCS_FEDRAMP"} {
+			if previous, exists := os.LookupEnv(name); exists {
+				Expect(os.Unsetenv(name)).To(Succeed())
+				DeferCleanup(os.Setenv, name, previous)
+			}
+		}
+
+		fileAccess = sdktesting.MakeTokenString("Bearer", 10*time.Minute)
+		fileRefresh = sdktesting.MakeTokenString("Refresh", 10*time.Hour)
+		content, err := json.Marshal(map[string]interface{}{
+			"access_token":  fileAccess,
+			"refresh_token": fileRefresh,
+			"client_id":     "file-client",
+			"client_secret": "file-secret",
+			"scopes":        []string{"openid", "file-scope"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		configFile = filepath.Join(GinkgoT().TempDir(), "ocm.json")
+		Expect(os.WriteFile(configFile, content, 0600)).To(Succeed())
+	})
+
+	It("Uses the credentials of the configuration file when none are set", func() {
+		connection, diags := configure(map[string]string{
+			"config_file": configFile,
+		})
+		Expect(diags.HasError()).To(BeFalse(), "%v", diags)
+		id, secret := connection.Client()
+		Expect(id).To(Equal("file-client"))
+		Expect(secret).To(Equal("file-secret"))
+		Expect(connection.Scopes()).To(ConsistOf("openid", "file-scope"))
+		access, refresh, err := connection.Tokens()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(access).To(Equal(fileAccess))
+		Expect(refresh).To(Equal(fileRefresh))
+	})
+
+	It("Ignores the credentials of the configuration file when the token is set", func() {
+		token := sdktesting.MakeTokenString("Bearer", 10*time.Minute)
+		connection, diags := configure(map[string]string{
+			"config_file": configFile,
+			"token":       token,
+		})
+		Expect(diags.HasError()).To(BeFalse(), "%v", diags)
+		id, secret := connection.Client()
+		Expect(id).To(Equal(sdk.DefaultClientID))
+		Expect(secret).To(BeEmpty())
+		Expect(connection.Scopes()).To(Equal(sdk.DefaultScopes))
+		access, refresh, err := connection.Tokens()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(access).To(Equal(token))
+		Expect(refresh).To(BeEmpty())
+	})
+
+	It("Ignores the tokens and scopes of the configuration file when the client is set", func() {
+		serverAccess := sdktesting.MakeTokenString("Bearer", 10*time.Minute)
+		connection, diags := configure(map[string]string{
+			"config_file":   configFile,
+			"client_id":     "my-client",
+			"client_secret": "my-secret",
+			"token_url":     tokenServer("my-client", "my-secret", serverAccess),
+		})
+		Expect(diags.HasError()).To(BeFalse(), "%v", diags)
+		id, secret := connection.Client()
+		Expect(id).To(Equal("my-client"))
+		Expect(secret).To(Equal("my-secret"))
+		Expect(connection.Scopes()).To(Equal(sdk.DefaultScopes))
+		access, _, err := connection.Tokens()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(access).To(Equal(serverAccess))
+	})
+
+	It("Fills in the client secret of the configuration file for its client", func() {
+		serverAccess := sdktesting.MakeTokenString("Bearer", 10*time.Minute)
+		connection, diags := configure(map[string]string{
+			"config_file": configFile,
+			"client_id":   "file-client",
+			"token_url":   tokenServer("file-client", "file-secret", serverAccess),
+		})
+		Expect(diags.HasError()).To(BeFalse(), "%v", diags)
+		id, secret := connection.Client()
+		Expect(id).To(Equal("file-client"))
+		Expect(secret).To(Equal("file-secret"))
+		access, _, err := connection.Tokens()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(access).To(Equal(serverAccess))
+	})
+
+	It("Fills in the client identifier of the configuration file", func() {
+		connection, diags := configure(map[string]string{
+			"config_file":   configFile,
+			"client_secret": "my-secret",
+		})
+		Expect(diags.HasError()).To(BeFalse(), "%v", diags)
+		id, secret := connection.Client()
+		Expect(id).To(Equal("file-client"))
+		Expect(secret).To(Equal("my-secret"))
+		Expect(connection.Scopes()).To(Equal(sdk.DefaultScopes))
+	})
+
+	It("Doesn't use the client secret of the configuration file for other clients", func() {
+		_, diags := configure(map[string]string{
+			"config_file": configFile,
+			"client_id":   "my-client",
+		})
+		Expect(diags.HasError()).To(BeTrue())
+	})
+})
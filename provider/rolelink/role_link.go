@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rolelink
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+const (
+	// Keys of the labels where the linked role ARNs are stored, as a comma separated list. These
+	// are the same labels used by the `rosa link ocm-role` and `rosa link user-role` commands.
+	ocmRoleLabel  = "sts_ocm_role"
+	userRoleLabel = "sts_user_role"
+)
+
+// labelsMutexKV serializes the changes to the labels where the linked roles are stored, because
+// they are read, changed and written back as a whole, and Terraform creates and deletes the links
+// in parallel.
+var labelsMutexKV = common.NewMutexKV()
+
+var roleArnRE = regexp.MustCompile(
+	`^arn:` + common.AwsPartitionRegexp + `:iam::\d{12}:role/(?:[\x21-\x7E]*/)?[\w+=,.@-]{1,64}$`,
+)
+
+// getCurrentAccount returns the account that the provider is authenticated with, the same
+// returned by the 'rhcs_info' data source.
+func getCurrentAccount(ctx context.Context, client *amv1.CurrentAccountClient) (*amv1.Account, error) {
+	get, err := client.Get().SendContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return get.Body(), nil
+}
+
+// parseImportID splits an import identifier of the form '[<owner_id>,]<role_arn>'.
+func parseImportID(id string) (ownerID string, roleArn string, ok bool) {
+	fields := strings.Split(id, ",")
+	switch {
+	case len(fields) == 1 && fields[0] != "":
+		return "", fields[0], true
+	case len(fields) == 2 && fields[0] != "" && fields[1] != "":
+		return fields[0], fields[1], true
+	}
+	return "", "", false
+}
+
+// getLinkedRoles returns the role ARNs stored in the given label. The result is empty when the
+// label doesn't exist.
+func getLinkedRoles(ctx context.Context, labels *amv1.GenericLabelsClient, key string) ([]string, bool, error) {
+	get, err := labels.Label(key).Get().SendContext(ctx)
+	if err != nil {
+		if get.Status() == http.StatusNotFound {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	roles := []string{}
+	for _, role := range strings.Split(get.Body().Value(), ",") {
+		role = strings.TrimSpace(role)
+		if role != "" {
+			roles = append(roles, role)
+		}
+	}
+	return roles, true, nil
+}
+
+func isRoleLinked(ctx context.Context, labels *amv1.GenericLabelsClient, key string, roleArn string) (bool, error) {
+	roles, _, err := getLinkedRoles(ctx, labels, key)
+	if err != nil {
+		return false, err
+	}
+	for _, role := range roles {
+		if role == roleArn {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// linkRole adds the role ARN to the given label of the owner, creating the label if needed. Only
+// one role of each AWS account can be linked, so it fails if a different role of the same AWS
+// account is already present.
+func linkRole(ctx context.Context, labels *amv1.GenericLabelsClient, owner string, key string, roleArn string) error {
+	parsedArn, err := arn.Parse(roleArn)
+	if err != nil {
+		return err
+	}
+	lockKey := owner + "/" + key
+	labelsMutexKV.Lock(lockKey)
+	defer labelsMutexKV.Unlock(lockKey)
+	roles, exists, err := getLinkedRoles(ctx, labels, key)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if role == roleArn {
+			return nil
+		}
+		linked, err := arn.Parse(role)
+		if err == nil && linked.AccountID == parsedArn.AccountID {
+			return fmt.Errorf(
+				"role '%s' is already linked, only one role can be linked per AWS account", role,
+			)
+		}
+	}
+	roles = append(roles, roleArn)
+	label, err := amv1.NewLabel().Key(key).Value(strings.Join(roles, ",")).Build()
+	if err != nil {
+		return err
+	}
+	if !exists {
+		_, err = labels.Add().Body(label).SendContext(ctx)
+		return err
+	}
+	_, err = labels.Label(key).Update().Body(label).SendContext(ctx)
+	return err
+}
+
+// unlinkRole removes the role ARN from the given label of the owner, and deletes the label if no
+// other role remains linked.
+func unlinkRole(ctx context.Context, labels *amv1.GenericLabelsClient, owner string, key string, roleArn string) error {
+	lockKey := owner + "/" + key
+	labelsMutexKV.Lock(lockKey)
+	defer labelsMutexKV.Unlock(lockKey)
+	roles, exists, err := getLinkedRoles(ctx, labels, key)
+	if err != nil || !exists {
+		return err
+	}
+	remaining := []string{}
+	for _, role := range roles {
+		if role != roleArn {
+			remaining = append(remaining, role)
+		}
+	}
+	if len(remaining) == len(roles) {
+		return nil
+	}
+	if len(remaining) == 0 {
+		remove, err := labels.Label(key).Delete().SendContext(ctx)
+		if err != nil && remove.Status() != http.StatusNotFound {
+			return err
+		}
+		return nil
+	}
+	label, err := amv1.NewLabel().Key(key).Value(strings.Join(remaining, ",")).Build()
+	if err != nil {
+		return err
+	}
+	_, err = labels.Label(key).Update().Body(label).SendContext(ctx)
+	return err
+}
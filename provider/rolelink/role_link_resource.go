@@ -0,0 +1,366 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rolelink
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+// roleLinkKind contains what is different for each kind of role link: the owner of the label where
+// the linked roles are stored, and the names used in the schema and in the messages.
+type roleLinkKind struct {
+	typeName         string
+	description      string
+	roleName         string
+	ownerName        string
+	ownerAttr        string
+	ownerDescription string
+	labelKey         string
+
+	// currentOwner describes the owner that the roles can be linked to, with a placeholder for its
+	// identifier.
+	currentOwner string
+
+	// currentOwnerID returns the identifier of the owner for the current account.
+	currentOwnerID func(account *amv1.Account) string
+
+	// labels returns the client for the labels of the owner with the given identifier.
+	labels func(client *amv1.Client, ownerID string) *amv1.GenericLabelsClient
+}
+
+var ocmRoleLinkKind = &roleLinkKind{
+	typeName: "_ocm_role_link",
+	description: "Link between an OCM role and the Red Hat organization, equivalent to " +
+		"'rosa link ocm-role'. Only one OCM role of each AWS account can be linked to the organization.",
+	roleName:  "OCM role",
+	ownerName: "organization",
+	ownerAttr: "organization_id",
+	ownerDescription: "Identifier of the organization the role is linked to. It must be the organization " +
+		"of the current account, as returned by the 'rhcs_info' data source, which is also the default.",
+	labelKey:     ocmRoleLabel,
+	currentOwner: "organization '%s' of the current account",
+	currentOwnerID: func(account *amv1.Account) string {
+		return account.Organization().ID()
+	},
+	labels: func(client *amv1.Client, ownerID string) *amv1.GenericLabelsClient {
+		return client.Organizations().Organization(ownerID).Labels()
+	},
+}
+
+var userRoleLinkKind = &roleLinkKind{
+	typeName: "_user_role_link",
+	description: "Link between a user role and the Red Hat user account, equivalent to " +
+		"'rosa link user-role'. Only one user role of each AWS account can be linked to the account.",
+	roleName:  "user role",
+	ownerName: "account",
+	ownerAttr: "account_id",
+	ownerDescription: "Identifier of the OCM account the role is linked to. It must be the current account, " +
+		"as returned by the 'rhcs_info' data source, which is also the default.",
+	labelKey:     userRoleLabel,
+	currentOwner: "the current account '%s'",
+	currentOwnerID: func(account *amv1.Account) string {
+		return account.ID()
+	},
+	labels: func(client *amv1.Client, ownerID string) *amv1.GenericLabelsClient {
+		return client.Accounts().Account(ownerID).Labels()
+	},
+}
+
+// RoleLinkResource implements the resources that link roles to the organization or to the account,
+// which only differ in their roleLinkKind.
+type RoleLinkResource struct {
+	kind           *roleLinkKind
+	client         *amv1.Client
+	currentAccount *amv1.CurrentAccountClient
+}
+
+var _ resource.Resource = &RoleLinkResource{}
+var _ resource.ResourceWithConfigure = &RoleLinkResource{}
+var _ resource.ResourceWithImportState = &RoleLinkResource{}
+var _ resource.ResourceWithModifyPlan = &RoleLinkResource{}
+
+func NewOcmRoleLinkResource() resource.Resource {
+	return &RoleLinkResource{kind: ocmRoleLinkKind}
+}
+
+func NewUserRoleLinkResource() resource.Resource {
+	return &RoleLinkResource{kind: userRoleLinkKind}
+}
+
+// roleLinkState is the state of a role link, the owner is read and written with the attribute of
+// the kind of link.
+type roleLinkState struct {
+	ID      types.String
+	OwnerID types.String
+	RoleArn types.String
+}
+
+func (r *RoleLinkResource) getState(ctx context.Context, source interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}) (*roleLinkState, diag.Diagnostics) {
+	state := &roleLinkState{}
+	var diags diag.Diagnostics
+	diags.Append(source.GetAttribute(ctx, path.Root("id"), &state.ID)...)
+	diags.Append(source.GetAttribute(ctx, path.Root(r.kind.ownerAttr), &state.OwnerID)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("role_arn"), &state.RoleArn)...)
+	return state, diags
+}
+
+func (r *RoleLinkResource) setState(ctx context.Context, target *tfsdk.State, state *roleLinkState) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(target.SetAttribute(ctx, path.Root("id"), state.ID)...)
+	diags.Append(target.SetAttribute(ctx, path.Root(r.kind.ownerAttr), state.OwnerID)...)
+	diags.Append(target.SetAttribute(ctx, path.Root("role_arn"), state.RoleArn)...)
+	return diags
+}
+
+func (r *RoleLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.kind.typeName
+}
+
+func (r *RoleLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: r.kind.description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the link, the ARN of the role.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			r.kind.ownerAttr: schema.StringAttribute{
+				Description: r.kind.ownerDescription + common.ValueCannotBeChangedStringDescription,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				Description: "ARN of the " + r.kind.roleName + "." + common.ValueCannotBeChangedStringDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(roleArnRE, "must be the ARN of an IAM role"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RoleLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*sdk.Connection)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Connection, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = connection.AccountsMgmt().V1()
+	r.currentAccount = connection.AccountsMgmt().V1().CurrentAccount()
+}
+
+func (r *RoleLinkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the link is being destroyed:
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	plan, diags := r.getState(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		state, diags := r.getState(ctx, req.State)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.OwnerID.Equal(state.OwnerID) && plan.RoleArn.Equal(state.RoleArn) {
+			return
+		}
+	}
+	if plan.RoleArn.IsUnknown() {
+		return
+	}
+
+	account, err := getCurrentAccount(ctx, r.currentAccount)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't get current account",
+			fmt.Sprintf("Can't get the account to link role '%s' to: %v", plan.RoleArn.ValueString(), err),
+		)
+		return
+	}
+	ownerID := r.kind.currentOwnerID(account)
+	if plan.OwnerID.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(r.kind.ownerAttr), ownerID)...)
+		return
+	}
+	if plan.OwnerID.ValueString() != ownerID {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(r.kind.ownerAttr),
+			"Invalid "+r.kind.ownerName,
+			fmt.Sprintf(
+				"Role '%s' can only be linked to %s, as returned by the 'rhcs_info' data source, "+
+					"but %s '%s' was given",
+				plan.RoleArn.ValueString(), fmt.Sprintf(r.kind.currentOwner, ownerID),
+				r.kind.ownerName, plan.OwnerID.ValueString(),
+			),
+		)
+	}
+}
+
+func (r *RoleLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan, diags := r.getState(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ownerID := plan.OwnerID.ValueString()
+	roleArn := plan.RoleArn.ValueString()
+	err := linkRole(ctx, r.kind.labels(r.client, ownerID), r.kind.ownerName+"/"+ownerID, r.kind.labelKey, roleArn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't link "+r.kind.roleName,
+			fmt.Sprintf("Can't link %s '%s' to %s '%s': %v", r.kind.roleName, roleArn, r.kind.ownerName, ownerID, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(roleArn)
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, plan)...)
+}
+
+func (r *RoleLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state, diags := r.getState(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported links may not include the owner, in that case it is the one of the current account:
+	if state.OwnerID.IsNull() || state.OwnerID.ValueString() == "" {
+		account, err := getCurrentAccount(ctx, r.currentAccount)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't get current account",
+				fmt.Sprintf("Can't get the current account: %v", err),
+			)
+			return
+		}
+		state.OwnerID = types.StringValue(r.kind.currentOwnerID(account))
+	}
+
+	ownerID := state.OwnerID.ValueString()
+	roleArn := state.RoleArn.ValueString()
+	linked, err := isRoleLinked(ctx, r.kind.labels(r.client, ownerID), r.kind.labelKey, roleArn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't find "+r.kind.roleName+" link",
+			fmt.Sprintf("Can't find link of %s '%s' to %s '%s': %v", r.kind.roleName, roleArn, r.kind.ownerName, ownerID, err),
+		)
+		return
+	}
+	if !linked {
+		tflog.Warn(ctx, r.kind.roleName+" link not found, removing from state", map[string]interface{}{
+			r.kind.ownerAttr: ownerID,
+			"role_arn":       roleArn,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(roleArn)
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, state)...)
+}
+
+func (r *RoleLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the attributes require replacement, so there is nothing to update:
+	plan, diags := r.getState(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, plan)...)
+}
+
+func (r *RoleLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state, diags := r.getState(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ownerID := state.OwnerID.ValueString()
+	roleArn := state.RoleArn.ValueString()
+	err := unlinkRole(ctx, r.kind.labels(r.client, ownerID), r.kind.ownerName+"/"+ownerID, r.kind.labelKey, roleArn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't unlink "+r.kind.roleName,
+			fmt.Sprintf("Can't unlink %s '%s' from %s '%s': %v", r.kind.roleName, roleArn, r.kind.ownerName, ownerID, err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *RoleLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ownerID, roleArn, ok := parseImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			fmt.Sprintf("%s link to import should be specified as <role_arn> or <%s>,<role_arn>",
+				r.kind.roleName, r.kind.ownerAttr),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), roleArn)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_arn"), roleArn)...)
+	if ownerID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.kind.ownerAttr), ownerID)...)
+	}
+}
@@ -0,0 +1,370 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa_account_roles
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
+	rosaTypes "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common/types"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+	ocmPolicies "github.com/terraform-redhat/terraform-provider-rhcs/provider/ocm_policies/common"
+)
+
+const (
+	DefaultAccountRolePrefix = "ManagedOpenShift"
+
+	supportTrustPolicyID = "sts_support_trust_policy"
+	maxRoleNameLength    = 64
+	maxPolicyNameLength  = 128
+
+	tagRedHatManaged    = "red-hat-managed"
+	tagRolePrefix       = "rosa_role_prefix"
+	tagRoleType         = "rosa_role_type"
+	tagManagedPolicies  = "rosa_managed_policies"
+	tagHcpPolicies      = "rosa_hcp_policies"
+	tagOpenShiftVersion = rosa.TagsOpenShiftVersion
+)
+
+// accountRole describes one of the account roles of a topology, and the identifiers of the
+// policies that the server returns for it.
+type accountRole struct {
+	roleType          string
+	nameSuffix        string
+	permissionsPolicy string
+	trustPolicy       string
+}
+
+var classicAccountRoles = []accountRole{
+	{"installer", "Installer-Role", "sts_installer_permission_policy", "sts_installer_trust_policy"},
+	{"support", "Support-Role", "sts_support_permission_policy", supportTrustPolicyID},
+	{"instance_worker", "Worker-Role", "sts_instance_worker_permission_policy", "sts_instance_worker_trust_policy"},
+	{"instance_controlplane", "ControlPlane-Role", "sts_instance_controlplane_permission_policy", "sts_instance_controlplane_trust_policy"},
+}
+
+var hcpAccountRoles = []accountRole{
+	{"installer", "HCP-ROSA-Installer-Role", "sts_hcp_installer_permission_policy", "sts_installer_trust_policy"},
+	{"support", "HCP-ROSA-Support-Role", "sts_hcp_support_permission_policy", supportTrustPolicyID},
+	{"instance_worker", "HCP-ROSA-Worker-Role", "sts_hcp_instance_worker_permission_policy", "sts_instance_worker_trust_policy"},
+}
+
+type AccountRolesDataSource struct {
+	topology     rosaTypes.ClusterTopology
+	awsInquiries *cmv1.AWSInquiriesClient
+}
+
+var _ datasource.DataSource = &AccountRolesDataSource{}
+var _ datasource.DataSourceWithConfigure = &AccountRolesDataSource{}
+
+func New() datasource.DataSource {
+	return &AccountRolesDataSource{topology: rosaTypes.Classic}
+}
+
+func NewHcp() datasource.DataSource {
+	return &AccountRolesDataSource{topology: rosaTypes.Hcp}
+}
+
+func (s *AccountRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	if s.topology == rosaTypes.Hcp {
+		resp.TypeName = req.ProviderTypeName + "_rosa_hcp_account_roles"
+		return
+	}
+	resp.TypeName = req.ProviderTypeName + "_rosa_account_roles"
+}
+
+func (s *AccountRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Account roles of ROSA classic clusters: installer, support, worker and control plane. " +
+		"The permissions of the roles are granted by customer managed policies, whose documents are included."
+	if s.topology == rosaTypes.Hcp {
+		description = "Account roles of ROSA clusters with hosted control plane: installer, support and worker. " +
+			"The permissions of the roles are granted by AWS managed policies."
+	}
+	resp.Schema = schema.Schema{
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"account_role_prefix": schema.StringAttribute{
+				Description: fmt.Sprintf("Prefix of the names of the account roles. Defaults to '%s'.", DefaultAccountRolePrefix),
+				Optional:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: common.IamPathDescription,
+				Optional:    true,
+				Validators:  common.IamPathValidators(),
+			},
+			"openshift_version": schema.StringAttribute{
+				Description: "OpenShift version that the roles are created for, for example '4.15'. " +
+					"Only the major and minor versions are used, to tag the roles.",
+				Optional: true,
+			},
+			"account_id": schema.StringAttribute{
+				Description: "AWS account ID where the account roles are created. Required to compute the ARNs.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{12}$`), "aws account ID must be only digits and exactly 12 in length"),
+				},
+			},
+			"aws_partition": schema.StringAttribute{
+				Description: common.AwsPartitionDescription,
+				Optional:    true,
+				Validators:  common.AwsPartitionValidators(),
+			},
+			"permissions_boundary": schema.StringAttribute{
+				Description: "ARN of the policy used as permissions boundary of the roles. It is copied to " +
+					"every role, so that it can be passed to the resources that create them.",
+				Optional: true,
+			},
+			"rh_support_role_arn": schema.StringAttribute{
+				Description: "ARN of the Red Hat role that is trusted by the support role.",
+				Computed:    true,
+			},
+			"account_roles": schema.ListNestedAttribute{
+				Description: "Account roles.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role_type": schema.StringAttribute{
+							Description: "Type of the role, one of 'installer', 'support', 'instance_worker' " +
+								"or 'instance_controlplane'.",
+							Computed: true,
+						},
+						"role_name": schema.StringAttribute{
+							Description: "Name of the role.",
+							Computed:    true,
+						},
+						"role_arn": schema.StringAttribute{
+							Description: "ARN of the role, only set when 'account_id' is set.",
+							Computed:    true,
+						},
+						"policy_name": schema.StringAttribute{
+							Description: "Name of the permissions policy of the role.",
+							Computed:    true,
+						},
+						"policy_arn": schema.StringAttribute{
+							Description: "ARN of the permissions policy of the role. For customer managed " +
+								"policies it is only set when 'account_id' is set.",
+							Computed: true,
+						},
+						"managed_policy": schema.BoolAttribute{
+							Description: "Indicates if the permissions policy is an AWS managed policy, that " +
+								"only needs to be attached. Otherwise the policy needs to be created from " +
+								"'policy_document'.",
+							Computed: true,
+						},
+						"policy_document": schema.StringAttribute{
+							Description: "Document of the permissions policy, in JSON format, only set for " +
+								"customer managed policies.",
+							Computed: true,
+						},
+						"assume_role_policy": schema.StringAttribute{
+							Description: "Trust policy of the role, in JSON format.",
+							Computed:    true,
+						},
+						"permissions_boundary": schema.StringAttribute{
+							Description: "ARN of the permissions boundary of the role, if any.",
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "Tags that identify the role as a ROSA account role.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (s *AccountRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection := req.ProviderData.(*sdk.Connection)
+
+	// Get the collection of AWS inquiries:
+	s.awsInquiries = connection.ClustersMgmt().V1().AWSInquiries()
+}
+
+func (s *AccountRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &AccountRolesState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	openShiftVersion := ""
+	if common.HasValue(state.OpenShiftVersion) {
+		version, err := semver.NewVersion(strings.TrimPrefix(state.OpenShiftVersion.ValueString(), rosa.VersionPrefix))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid OpenShift version",
+				fmt.Sprintf("Can't parse version '%s': %v", state.OpenShiftVersion.ValueString(), err),
+			)
+			return
+		}
+		segments := version.Segments()
+		openShiftVersion = fmt.Sprintf("%d.%d", segments[0], segments[1])
+	}
+
+	policiesResponse, err := s.awsInquiries.STSPolicies().List().SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't list policies",
+			common.HandleErr(policiesResponse.Error(), err).Error(),
+		)
+		return
+	}
+	policies := map[string]*cmv1.AWSSTSPolicy{}
+	policiesResponse.Items().Each(func(awsPolicy *cmv1.AWSSTSPolicy) bool {
+		tflog.Debug(ctx, fmt.Sprintf("policy id: %s ", awsPolicy.ID()))
+		policies[awsPolicy.ID()] = awsPolicy
+		return true
+	})
+
+	prefix := DefaultAccountRolePrefix
+	if common.HasValue(state.AccountRolePrefix) {
+		prefix = state.AccountRolePrefix.ValueString()
+	}
+	partition := common.AwsPartition
+	if common.HasValue(state.AwsPartition) {
+		partition = state.AwsPartition.ValueString()
+	}
+	path := "/"
+	if common.HasValue(state.Path) {
+		path = state.Path.ValueString()
+	}
+
+	state.RhSupportRoleARN = types.StringNull()
+	if supportTrustPolicy, ok := policies[supportTrustPolicyID]; ok {
+		rhSupportRole, err := ocmPolicies.ParseRhSupportRole(ctx, supportTrustPolicy.Details())
+		if err != nil {
+			resp.Diagnostics.AddError("Can't find the Red Hat support role", err.Error())
+			return
+		}
+		state.RhSupportRoleARN = types.StringValue(rhSupportRole)
+	}
+
+	roles := classicAccountRoles
+	if s.topology == rosaTypes.Hcp {
+		roles = hcpAccountRoles
+	}
+	state.AccountRoles = []*AccountRoleState{}
+	for _, role := range roles {
+		roleName := getRoleName(prefix, role)
+		item := &AccountRoleState{
+			RoleType:            types.StringValue(role.roleType),
+			RoleName:            types.StringValue(roleName),
+			RoleARN:             types.StringNull(),
+			PolicyARN:           types.StringNull(),
+			ManagedPolicy:       types.BoolValue(s.topology == rosaTypes.Hcp),
+			PolicyDocument:      types.StringNull(),
+			AssumeRolePolicy:    types.StringNull(),
+			PermissionsBoundary: state.PermissionsBoundary,
+		}
+		if common.HasValue(state.AccountID) {
+			item.RoleARN = types.StringValue(common.BuildIamArn(partition, state.AccountID.ValueString(),
+				"role", path, roleName))
+		}
+
+		permissionsPolicy, ok := policies[role.permissionsPolicy]
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Can't find account role policy",
+				fmt.Sprintf("Can't find policy '%s' of the %s role", role.permissionsPolicy, role.roleType),
+			)
+			return
+		}
+		if s.topology == rosaTypes.Hcp {
+			policyARN := common.ArnWithPartition(permissionsPolicy.ARN(), partition)
+			item.PolicyARN = types.StringValue(policyARN)
+			item.PolicyName = types.StringValue(policyARN[strings.LastIndex(policyARN, "/")+1:])
+		} else {
+			policyName := getPolicyName(roleName)
+			item.PolicyName = types.StringValue(policyName)
+			item.PolicyDocument = types.StringValue(common.PolicyWithPartition(permissionsPolicy.Details(), partition))
+			if common.HasValue(state.AccountID) {
+				item.PolicyARN = types.StringValue(common.BuildIamArn(partition, state.AccountID.ValueString(),
+					"policy", path, policyName))
+			}
+		}
+		if trustPolicy, ok := policies[role.trustPolicy]; ok {
+			item.AssumeRolePolicy = types.StringValue(common.PolicyWithPartition(trustPolicy.Details(), partition))
+		}
+
+		tags, err := common.ConvertStringMapToMapType(s.buildTags(prefix, role, openShiftVersion))
+		if err != nil {
+			resp.Diagnostics.AddError("Can't build account role tags", err.Error())
+			return
+		}
+		item.Tags = tags
+		state.AccountRoles = append(state.AccountRoles, item)
+	}
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (s *AccountRolesDataSource) buildTags(prefix string, role accountRole, openShiftVersion string) map[string]string {
+	tags := map[string]string{
+		tagRedHatManaged: "true",
+		tagRolePrefix:    prefix,
+		tagRoleType:      role.roleType,
+	}
+	if openShiftVersion != "" {
+		tags[tagOpenShiftVersion] = openShiftVersion
+	}
+	if s.topology == rosaTypes.Hcp {
+		tags[tagManagedPolicies] = "true"
+		tags[tagHcpPolicies] = "true"
+	}
+	return tags
+}
+
+func getRoleName(prefix string, role accountRole) string {
+	name := fmt.Sprintf("%s-%s", prefix, role.nameSuffix)
+	if len(name) > maxRoleNameLength {
+		name = name[0:maxRoleNameLength]
+	}
+	return name
+}
+
+func getPolicyName(roleName string) string {
+	name := fmt.Sprintf("%s-Policy", roleName)
+	if len(name) > maxPolicyNameLength {
+		name = name[0:maxPolicyNameLength]
+	}
+	return name
+}
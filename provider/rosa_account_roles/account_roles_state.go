@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa_account_roles
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type AccountRolesState struct {
+	AccountRolePrefix   types.String        `tfsdk:"account_role_prefix"`
+	Path                types.String        `tfsdk:"path"`
+	OpenShiftVersion    types.String        `tfsdk:"openshift_version"`
+	AccountID           types.String        `tfsdk:"account_id"`
+	AwsPartition        types.String        `tfsdk:"aws_partition"`
+	PermissionsBoundary types.String        `tfsdk:"permissions_boundary"`
+	RhSupportRoleARN    types.String        `tfsdk:"rh_support_role_arn"`
+	AccountRoles        []*AccountRoleState `tfsdk:"account_roles"`
+}
+
+type AccountRoleState struct {
+	RoleType            types.String `tfsdk:"role_type"`
+	RoleName            types.String `tfsdk:"role_name"`
+	RoleARN             types.String `tfsdk:"role_arn"`
+	PolicyName          types.String `tfsdk:"policy_name"`
+	PolicyARN           types.String `tfsdk:"policy_arn"`
+	ManagedPolicy       types.Bool   `tfsdk:"managed_policy"`
+	PolicyDocument      types.String `tfsdk:"policy_document"`
+	AssumeRolePolicy    types.String `tfsdk:"assume_role_policy"`
+	PermissionsBoundary types.String `tfsdk:"permissions_boundary"`
+	Tags                types.Map    `tfsdk:"tags"`
+}
@@ -19,10 +19,13 @@ package classic
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 
@@ -63,6 +66,34 @@ func (s *RosaOperatorRolesDataSource) Schema(ctx context.Context, req datasource
 				Description: "Account role prefix.",
 				Optional:    true,
 			},
+			"account_id": schema.StringAttribute{
+				Description: "AWS account ID where the operator roles are created. Required to compute the ARNs.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{12}$`), "aws account ID must be only digits and exactly 12 in length"),
+				},
+			},
+			"aws_partition": schema.StringAttribute{
+				Description: common.AwsPartitionDescription,
+				Optional:    true,
+				Validators:  common.AwsPartitionValidators(),
+			},
+			"path": schema.StringAttribute{
+				Description: common.IamPathDescription,
+				Optional:    true,
+				Validators:  common.IamPathValidators(),
+			},
+			"oidc_endpoint_url": schema.StringAttribute{
+				Description: "URL of the OIDC endpoint of the cluster, for example the 'oidc_endpoint_url' " +
+					"attribute of the cluster resource. Required, together with 'account_id', to compute " +
+					"the trust policies of the roles.",
+				Optional: true,
+			},
+			"permissions_boundary": schema.StringAttribute{
+				Description: "ARN of the policy used as permissions boundary of the roles. It is copied to " +
+					"every role, so that it can be passed to the resources that create them.",
+				Optional: true,
+			},
 			"operator_iam_roles": schema.ListNestedAttribute{
 				Description: "Operator IAM Roles.",
 				NestedObject: schema.NestedAttributeObject{
@@ -83,6 +114,24 @@ func (s *RosaOperatorRolesDataSource) Schema(ctx context.Context, req datasource
 							Description: "policy name",
 							Computed:    true,
 						},
+						"policy_arn": schema.StringAttribute{
+							Description: "ARN of the policy, only set when 'account_id' is set.",
+							Computed:    true,
+						},
+						"role_arn": schema.StringAttribute{
+							Description: "ARN of the role, only set when 'account_id' is set.",
+							Computed:    true,
+						},
+						"assume_role_policy": schema.StringAttribute{
+							Description: "Trust policy of the role, in JSON format, that allows the service " +
+								"accounts of the operator to assume it. Only set when 'account_id' and " +
+								"'oidc_endpoint_url' are set.",
+							Computed: true,
+						},
+						"permissions_boundary": schema.StringAttribute{
+							Description: "ARN of the permissions boundary of the role, if any.",
+							Computed:    true,
+						},
 						"service_accounts": schema.ListAttribute{
 							Description: "service accounts",
 							ElementType: types.StringType,
@@ -152,17 +201,50 @@ func (s *RosaOperatorRolesDataSource) Read(ctx context.Context, req datasource.R
 		accountRolePrefix = state.AccountRolePrefix.ValueString()
 	}
 
+	partition := common.AwsPartition
+	if common.HasValue(state.AwsPartition) {
+		partition = state.AwsPartition.ValueString()
+	}
+	path := "/"
+	if common.HasValue(state.Path) {
+		path = state.Path.ValueString()
+	}
+
 	// TODO: use the sts.OperatorRolePrefix() if not empty
 	// There is a bug in the return value of sts.OperatorRolePrefix() - it's always empty string
 	sort.Strings(roleNameSpaces)
 	for _, key := range roleNameSpaces {
 		v := stsOperatorMap[key]
+		roleName := getRoleName(state.OperatorRolePrefix.ValueString(), v)
+		policyName := getPolicyName(accountRolePrefix, v.Namespace(), v.Name())
+		serviceAccounts := getServiceAccounts(v.ServiceAccounts(), v.Namespace())
 		r := OperatorIAMRole{
-			Name:            types.StringValue(v.Name()),
-			Namespace:       types.StringValue(v.Namespace()),
-			RoleName:        types.StringValue(getRoleName(state.OperatorRolePrefix.ValueString(), v)),
-			PolicyName:      types.StringValue(getPolicyName(accountRolePrefix, v.Namespace(), v.Name())),
-			ServiceAccounts: buildServiceAccountsArray(stsOperatorMap[v.Namespace()].ServiceAccounts(), v.Namespace()),
+			Name:                types.StringValue(v.Name()),
+			Namespace:           types.StringValue(v.Namespace()),
+			RoleName:            types.StringValue(roleName),
+			PolicyName:          types.StringValue(policyName),
+			PolicyARN:           types.StringNull(),
+			RoleARN:             types.StringNull(),
+			AssumeRolePolicy:    types.StringNull(),
+			PermissionsBoundary: state.PermissionsBoundary,
+			ServiceAccounts:     buildServiceAccountsArray(serviceAccounts),
+		}
+		if common.HasValue(state.AccountID) {
+			accountID := state.AccountID.ValueString()
+			r.PolicyARN = types.StringValue(common.BuildIamArn(partition, accountID, "policy", path, policyName))
+			r.RoleARN = types.StringValue(common.BuildIamArn(partition, accountID, "role", path, roleName))
+			if common.HasValue(state.OidcEndpointURL) {
+				trustPolicy, err := common.BuildOperatorRoleTrustPolicy(partition, accountID,
+					state.OidcEndpointURL.ValueString(), serviceAccounts)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Can't build trust policy",
+						fmt.Sprintf("Can't build trust policy of operator role '%s': %v", roleName, err),
+					)
+					return
+				}
+				r.AssumeRolePolicy = types.StringValue(trustPolicy)
+			}
 		}
 		state.OperatorIAMRoles = append(state.OperatorIAMRoles, &r)
 	}
@@ -190,12 +272,15 @@ func getPolicyName(prefix string, namespace string, name string) string {
 	return policy
 }
 
-func buildServiceAccountsArray(serviceAccountArr []string, operatorNamespace string) types.List {
+func getServiceAccounts(serviceAccountArr []string, operatorNamespace string) []string {
 	svcAcctList := []string{}
 	for _, v := range serviceAccountArr {
 		svcAcctList = append(svcAcctList, fmt.Sprintf(serviceAccountFmt, operatorNamespace, v))
 	}
+	return svcAcctList
+}
 
+func buildServiceAccountsArray(svcAcctList []string) types.List {
 	serviceAccounts, _ := types.ListValueFrom(context.TODO(), types.StringType, svcAcctList)
 	return serviceAccounts
 }
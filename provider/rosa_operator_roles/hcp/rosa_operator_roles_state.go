@@ -19,15 +19,24 @@ package hcp
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type RosaOperatorRolesState struct {
-	OperatorRolePrefix types.String       `tfsdk:"operator_role_prefix"`
-	AccountRolePrefix  types.String       `tfsdk:"account_role_prefix"`
-	OperatorIAMRoles   []*OperatorIAMRole `tfsdk:"operator_iam_roles"`
+	OperatorRolePrefix  types.String       `tfsdk:"operator_role_prefix"`
+	AccountRolePrefix   types.String       `tfsdk:"account_role_prefix"`
+	AccountID           types.String       `tfsdk:"account_id"`
+	AwsPartition        types.String       `tfsdk:"aws_partition"`
+	Path                types.String       `tfsdk:"path"`
+	OidcEndpointURL     types.String       `tfsdk:"oidc_endpoint_url"`
+	PermissionsBoundary types.String       `tfsdk:"permissions_boundary"`
+	OperatorIAMRoles    []*OperatorIAMRole `tfsdk:"operator_iam_roles"`
 }
 
 type OperatorIAMRole struct {
-	Name            types.String `tfsdk:"operator_name"`
-	Namespace       types.String `tfsdk:"operator_namespace"`
-	RoleName        types.String `tfsdk:"role_name"`
-	PolicyName      types.String `tfsdk:"policy_name"`
-	ServiceAccounts types.List   `tfsdk:"service_accounts"`
+	Name                types.String `tfsdk:"operator_name"`
+	Namespace           types.String `tfsdk:"operator_namespace"`
+	RoleName            types.String `tfsdk:"role_name"`
+	PolicyName          types.String `tfsdk:"policy_name"`
+	PolicyARN           types.String `tfsdk:"policy_arn"`
+	RoleARN             types.String `tfsdk:"role_arn"`
+	AssumeRolePolicy    types.String `tfsdk:"assume_role_policy"`
+	PermissionsBoundary types.String `tfsdk:"permissions_boundary"`
+	ServiceAccounts     types.List   `tfsdk:"service_accounts"`
 }
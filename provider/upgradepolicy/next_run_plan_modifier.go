@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgradepolicy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nextRunPlanModifier keeps the next run calculated by the server in the plan, unless the schedule
+// changes, as in that case the server will calculate a new one.
+type nextRunPlanModifier struct{}
+
+var _ planmodifier.String = nextRunPlanModifier{}
+
+func (m nextRunPlanModifier) Description(ctx context.Context) string {
+	return "The next run is kept unless the schedule changes."
+}
+
+func (m nextRunPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m nextRunPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest,
+	resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var stateSchedule, planSchedule types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("schedule"), &stateSchedule)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("schedule"), &planSchedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !stateSchedule.Equal(planSchedule) {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgradepolicy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+// upgradePolicy is the part of the classic and hosted control plane upgrade policies that the
+// resource manages.
+type upgradePolicy struct {
+	ID               string
+	ScheduleType     cmv1.ScheduleType
+	Schedule         string
+	Version          string
+	NextRun          time.Time
+	State            cmv1.UpgradePolicyStateValue
+	StateDescription string
+}
+
+// upgradePolicyClient hides the differences between the upgrade policies of classic clusters and
+// the control plane upgrade policies of hosted control plane clusters.
+type upgradePolicyClient struct {
+	cluster *cmv1.ClusterClient
+	hcp     bool
+}
+
+func newUpgradePolicyClient(collection *cmv1.ClustersClient, cluster *cmv1.Cluster) *upgradePolicyClient {
+	return &upgradePolicyClient{
+		cluster: collection.Cluster(cluster.ID()),
+		hcp:     cluster.Hypershift().Enabled(),
+	}
+}
+
+func (c *upgradePolicyClient) Create(ctx context.Context, policy *upgradePolicy) (*upgradePolicy, error) {
+	if c.hcp {
+		builder := cmv1.NewControlPlaneUpgradePolicy().ScheduleType(policy.ScheduleType)
+		if policy.Schedule != "" {
+			builder.Schedule(policy.Schedule)
+		}
+		if policy.Version != "" {
+			builder.Version(policy.Version)
+		}
+		if !policy.NextRun.IsZero() {
+			builder.NextRun(policy.NextRun)
+		}
+		object, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.cluster.ControlPlane().UpgradePolicies().Add().Body(object).SendContext(ctx)
+		if err != nil {
+			return nil, common.HandleErr(resp.Error(), err)
+		}
+		return c.get(ctx, resp.Body().ID())
+	}
+
+	builder := cmv1.NewUpgradePolicy().ScheduleType(policy.ScheduleType)
+	if policy.Schedule != "" {
+		builder.Schedule(policy.Schedule)
+	}
+	if policy.Version != "" {
+		builder.Version(policy.Version)
+	}
+	if !policy.NextRun.IsZero() {
+		builder.NextRun(policy.NextRun)
+	}
+	object, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.cluster.UpgradePolicies().Add().Body(object).SendContext(ctx)
+	if err != nil {
+		return nil, common.HandleErr(resp.Error(), err)
+	}
+	return c.get(ctx, resp.Body().ID())
+}
+
+func (c *upgradePolicyClient) get(ctx context.Context, id string) (*upgradePolicy, error) {
+	policy, err := c.Get(ctx, id)
+	if err == nil && policy == nil {
+		err = fmt.Errorf("upgrade policy '%s' not found", id)
+	}
+	return policy, err
+}
+
+// Get returns the upgrade policy with the given identifier, or nil if it doesn't exist.
+func (c *upgradePolicyClient) Get(ctx context.Context, id string) (*upgradePolicy, error) {
+	if c.hcp {
+		resp, err := c.cluster.ControlPlane().UpgradePolicies().ControlPlaneUpgradePolicy(id).Get().SendContext(ctx)
+		if resp.Status() == http.StatusNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, common.HandleErr(resp.Error(), err)
+		}
+		object := resp.Body()
+		return &upgradePolicy{
+			ID:               object.ID(),
+			ScheduleType:     object.ScheduleType(),
+			Schedule:         object.Schedule(),
+			Version:          object.Version(),
+			NextRun:          object.NextRun(),
+			State:            object.State().Value(),
+			StateDescription: object.State().Description(),
+		}, nil
+	}
+
+	policyClient := c.cluster.UpgradePolicies().UpgradePolicy(id)
+	resp, err := policyClient.Get().SendContext(ctx)
+	if resp.Status() == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, common.HandleErr(resp.Error(), err)
+	}
+	object := resp.Body()
+	stateResp, err := policyClient.State().Get().SendContext(ctx)
+	if err != nil {
+		return nil, common.HandleErr(stateResp.Error(), err)
+	}
+	return &upgradePolicy{
+		ID:               object.ID(),
+		ScheduleType:     object.ScheduleType(),
+		Schedule:         object.Schedule(),
+		Version:          object.Version(),
+		NextRun:          object.NextRun(),
+		State:            stateResp.Body().Value(),
+		StateDescription: stateResp.Body().Description(),
+	}, nil
+}
+
+// Update sends the schedule and the next run of the given policy to the server.
+func (c *upgradePolicyClient) Update(ctx context.Context, policy *upgradePolicy) (*upgradePolicy, error) {
+	if c.hcp {
+		builder := cmv1.NewControlPlaneUpgradePolicy()
+		if policy.Schedule != "" {
+			builder.Schedule(policy.Schedule)
+		}
+		if !policy.NextRun.IsZero() {
+			builder.NextRun(policy.NextRun)
+		}
+		object, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.cluster.ControlPlane().UpgradePolicies().ControlPlaneUpgradePolicy(policy.ID).
+			Update().Body(object).SendContext(ctx)
+		if err != nil {
+			return nil, common.HandleErr(resp.Error(), err)
+		}
+		return c.get(ctx, policy.ID)
+	}
+
+	builder := cmv1.NewUpgradePolicy()
+	if policy.Schedule != "" {
+		builder.Schedule(policy.Schedule)
+	}
+	if !policy.NextRun.IsZero() {
+		builder.NextRun(policy.NextRun)
+	}
+	object, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.cluster.UpgradePolicies().UpgradePolicy(policy.ID).
+		Update().Body(object).SendContext(ctx)
+	if err != nil {
+		return nil, common.HandleErr(resp.Error(), err)
+	}
+	return c.get(ctx, policy.ID)
+}
+
+// Delete deletes the upgrade policy, it doesn't fail if it doesn't exist.
+func (c *upgradePolicyClient) Delete(ctx context.Context, id string) error {
+	if c.hcp {
+		resp, err := c.cluster.ControlPlane().UpgradePolicies().ControlPlaneUpgradePolicy(id).Delete().SendContext(ctx)
+		if err != nil && resp.Status() != http.StatusNotFound {
+			return common.HandleErr(resp.Error(), err)
+		}
+		return nil
+	}
+	resp, err := c.cluster.UpgradePolicies().UpgradePolicy(id).Delete().SendContext(ctx)
+	if err != nil && resp.Status() != http.StatusNotFound {
+		return common.HandleErr(resp.Error(), err)
+	}
+	return nil
+}
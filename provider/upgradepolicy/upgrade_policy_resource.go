@@ -0,0 +1,504 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgradepolicy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+const (
+	failedToCreateSummary = "Failed to create upgrade policy"
+	failedToUpdateSummary = "Failed to update upgrade policy"
+	failedToDeleteSummary = "Failed to delete upgrade policy"
+	failedToReadSummary   = "Failed to read upgrade policy"
+
+	nodeDrainGracePeriodUnit = "minutes"
+)
+
+// Grace periods accepted by the service for the node drain, in minutes.
+var validNodeDrainGracePeriods = []int64{15, 30, 45, 60, 120, 240, 480}
+
+type ClusterUpgradePolicyResource struct {
+	collection  *cmv1.ClustersClient
+	clusterWait common.ClusterWait
+}
+
+var _ resource.Resource = &ClusterUpgradePolicyResource{}
+var _ resource.ResourceWithConfigure = &ClusterUpgradePolicyResource{}
+var _ resource.ResourceWithImportState = &ClusterUpgradePolicyResource{}
+var _ resource.ResourceWithValidateConfig = &ClusterUpgradePolicyResource{}
+
+func New() resource.Resource {
+	return &ClusterUpgradePolicyResource{}
+}
+
+func (r *ClusterUpgradePolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_upgrade_policy"
+}
+
+func (r *ClusterUpgradePolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Upgrade policy of a ROSA classic cluster or of the control plane of a ROSA " +
+			"hosted control plane cluster. A cluster can have only one upgrade policy, so " +
+			"the 'version' attribute of the cluster resource shouldn't be changed while this resource exists.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the upgrade policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster." + common.ValueCannotBeChangedStringDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of the schedule, either '%s' to upgrade the cluster to the latest "+
+					"z-stream version with a recurring 'schedule', or '%s' to upgrade the cluster once to "+
+					"'version' at 'next_run'. Changing the value replaces the upgrade policy.",
+					cmv1.ScheduleTypeAutomatic, cmv1.ScheduleTypeManual),
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(cmv1.ScheduleTypeAutomatic), string(cmv1.ScheduleTypeManual)),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Description: "Cron expression, in UTC, of the recurring upgrades, for example '0 2 * * 6' " +
+					"for every Saturday at 02:00. Required when 'schedule_type' is 'automatic'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\S+( \S+){4}$`),
+						"must be a cron expression with five fields"),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Version to upgrade the cluster to, for example '4.15.3'. Required when " +
+					"'schedule_type' is 'manual'. Changing the value replaces the upgrade policy.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"next_run": schema.StringAttribute{
+				Description: "Date and time of the next upgrade, in RFC3339 format, for example " +
+					"'2024-06-01T02:00:00Z'. It can only be set when 'schedule_type' is 'manual', and " +
+					"it defaults to ten minutes after the creation of the upgrade policy.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					nextRunPlanModifier{},
+				},
+			},
+			"node_drain_grace_period": schema.Int64Attribute{
+				Description: fmt.Sprintf("Time, in minutes, that the pods protected by a pod disruption budget "+
+					"are respected when the nodes are drained during the upgrades. Only supported for "+
+					"ROSA classic clusters. Valid values are %s.", joinInt64s(validNodeDrainGracePeriods)),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(validNodeDrainGracePeriods...),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the upgrade policy, for example 'scheduled', 'started' or 'completed'.",
+				Computed:    true,
+			},
+			"state_description": schema.StringAttribute{
+				Description: "Description of the state of the upgrade policy.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *ClusterUpgradePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*sdk.Connection)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.collection = connection.ClustersMgmt().V1().Clusters()
+	r.clusterWait = common.NewClusterWait(r.collection)
+}
+
+func (r *ClusterUpgradePolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	config := &ClusterUpgradePolicyState{}
+	diags := req.Config.Get(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !common.HasValue(config.ScheduleType) {
+		return
+	}
+
+	switch cmv1.ScheduleType(config.ScheduleType.ValueString()) {
+	case cmv1.ScheduleTypeAutomatic:
+		if config.Schedule.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("schedule"), "Missing schedule",
+				"Attribute 'schedule' is required when 'schedule_type' is 'automatic'.")
+		}
+		if !config.Version.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("version"), "Unexpected version",
+				"Attribute 'version' can only be set when 'schedule_type' is 'manual', automatic "+
+					"upgrades always use the latest z-stream version.")
+		}
+		if !config.NextRun.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("next_run"), "Unexpected next run",
+				"Attribute 'next_run' can only be set when 'schedule_type' is 'manual', the next run "+
+					"of automatic upgrades is calculated from 'schedule'.")
+		}
+	case cmv1.ScheduleTypeManual:
+		if config.Version.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("version"), "Missing version",
+				"Attribute 'version' is required when 'schedule_type' is 'manual'.")
+		}
+		if !config.Schedule.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("schedule"), "Unexpected schedule",
+				"Attribute 'schedule' can only be set when 'schedule_type' is 'automatic'.")
+		}
+	}
+
+	if common.HasValue(config.Version) {
+		if _, err := semver.NewVersion(strings.TrimPrefix(config.Version.ValueString(), rosa.VersionPrefix)); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("version"), "Invalid version",
+				fmt.Sprintf("Can't parse version '%s': %v", config.Version.ValueString(), err))
+		}
+	}
+	if common.HasValue(config.NextRun) {
+		if _, err := time.Parse(time.RFC3339, config.NextRun.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("next_run"), "Invalid next run",
+				fmt.Sprintf("Can't parse next run '%s', it should be in RFC3339 format: %v",
+					config.NextRun.ValueString(), err))
+		}
+	}
+}
+
+func (r *ClusterUpgradePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &ClusterUpgradePolicyState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := plan.Cluster.ValueString()
+	waitTimeoutInMinutes := int64(60)
+	cluster, err := r.clusterWait.WaitForClusterToBeReady(ctx, clusterID, waitTimeoutInMinutes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Cluster is not ready",
+			fmt.Sprintf("Cluster with id '%s' is not in the ready state: %v", clusterID, err),
+		)
+		return
+	}
+
+	err = r.updateNodeDrainGracePeriod(ctx, cluster, nil, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(failedToCreateSummary,
+			fmt.Sprintf("Failed to update node drain grace period of cluster '%s': %v", clusterID, err))
+		return
+	}
+
+	policy := &upgradePolicy{
+		ScheduleType: cmv1.ScheduleType(plan.ScheduleType.ValueString()),
+		Schedule:     plan.Schedule.ValueString(),
+		Version:      strings.TrimPrefix(plan.Version.ValueString(), rosa.VersionPrefix),
+	}
+	if policy.ScheduleType == cmv1.ScheduleTypeManual {
+		policy.NextRun = time.Now().UTC().Add(10 * time.Minute)
+		if common.HasValue(plan.NextRun) {
+			policy.NextRun, _ = time.Parse(time.RFC3339, plan.NextRun.ValueString())
+		}
+	}
+	tflog.Debug(ctx, "Creating upgrade policy", map[string]interface{}{
+		"cluster":       clusterID,
+		"schedule_type": policy.ScheduleType,
+		"schedule":      policy.Schedule,
+		"version":       policy.Version,
+	})
+	policy, err = newUpgradePolicyClient(r.collection, cluster).Create(ctx, policy)
+	if err != nil {
+		resp.Diagnostics.AddError(failedToCreateSummary,
+			fmt.Sprintf("Failed to create upgrade policy for cluster '%s': %v", clusterID, err))
+		return
+	}
+
+	populateState(policy, plan)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ClusterUpgradePolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &ClusterUpgradePolicyState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := state.Cluster.ValueString()
+	cluster, err := common.NewClusterClient(r.collection).FetchCluster(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(failedToReadSummary, err.Error())
+		return
+	}
+
+	policy, err := newUpgradePolicyClient(r.collection, cluster).Get(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(failedToReadSummary,
+			fmt.Sprintf("Failed to read upgrade policy '%s' of cluster '%s': %v",
+				state.ID.ValueString(), clusterID, err))
+		return
+	}
+	if policy == nil {
+		// The service removes manual upgrade policies once the upgrade is done, in that case
+		// keep the resource so that the upgrade isn't scheduled again.
+		if isManualUpgradeDone(state, cluster) {
+			tflog.Debug(ctx, fmt.Sprintf("upgrade policy '%s' of cluster '%s' completed",
+				state.ID.ValueString(), clusterID))
+			state.State = types.StringValue(string(cmv1.UpgradePolicyStateValueCompleted))
+			state.StateDescription = types.StringNull()
+		} else {
+			tflog.Warn(ctx, fmt.Sprintf("upgrade policy '%s' of cluster '%s' not found, removing from state",
+				state.ID.ValueString(), clusterID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	} else {
+		populateState(policy, state)
+	}
+
+	if common.HasValue(state.NodeDrainGracePeriod) {
+		state.NodeDrainGracePeriod = types.Int64Value(int64(cluster.NodeDrainGracePeriod().Value()))
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ClusterUpgradePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	state := &ClusterUpgradePolicyState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan := &ClusterUpgradePolicyState{}
+	diags = req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := state.Cluster.ValueString()
+	cluster, err := common.NewClusterClient(r.collection).FetchCluster(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(failedToUpdateSummary, err.Error())
+		return
+	}
+
+	err = r.updateNodeDrainGracePeriod(ctx, cluster, state, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(failedToUpdateSummary,
+			fmt.Sprintf("Failed to update node drain grace period of cluster '%s': %v", clusterID, err))
+		return
+	}
+
+	policyClient := newUpgradePolicyClient(r.collection, cluster)
+	policy := &upgradePolicy{ID: state.ID.ValueString()}
+	patch := false
+	if schedule, ok := common.ShouldPatchString(state.Schedule, plan.Schedule); ok {
+		policy.Schedule = schedule
+		patch = true
+	}
+	if nextRun, ok := common.ShouldPatchString(state.NextRun, plan.NextRun); ok {
+		policy.NextRun, _ = time.Parse(time.RFC3339, nextRun)
+		patch = true
+	}
+	if patch {
+		policy, err = policyClient.Update(ctx, policy)
+	} else {
+		policy, err = policyClient.Get(ctx, policy.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(failedToUpdateSummary,
+			fmt.Sprintf("Failed to update upgrade policy '%s' of cluster '%s': %v",
+				state.ID.ValueString(), clusterID, err))
+		return
+	}
+	if policy == nil {
+		resp.Diagnostics.AddError(failedToUpdateSummary,
+			fmt.Sprintf("Upgrade policy '%s' of cluster '%s' doesn't exist anymore",
+				state.ID.ValueString(), clusterID))
+		return
+	}
+
+	populateState(policy, plan)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ClusterUpgradePolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &ClusterUpgradePolicyState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := state.Cluster.ValueString()
+	cluster, err := common.NewClusterClient(r.collection).FetchCluster(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(failedToDeleteSummary, err.Error())
+		return
+	}
+
+	err = newUpgradePolicyClient(r.collection, cluster).Delete(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(failedToDeleteSummary,
+			fmt.Sprintf("Failed to delete upgrade policy '%s' of cluster '%s': %v",
+				state.ID.ValueString(), clusterID, err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ClusterUpgradePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// To import an upgrade policy, we need to know the cluster ID and the upgrade policy ID
+	fields := strings.Split(req.ID, ",")
+	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			"Upgrade policy to import should be specified as <cluster_id>,<upgrade_policy_id>",
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster"), fields[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fields[1])...)
+}
+
+// updateNodeDrainGracePeriod patches the node drain grace period of the cluster when it changed.
+func (r *ClusterUpgradePolicyResource) updateNodeDrainGracePeriod(ctx context.Context, cluster *cmv1.Cluster,
+	state, plan *ClusterUpgradePolicyState) error {
+	stateValue := types.Int64Null()
+	if state != nil {
+		stateValue = state.NodeDrainGracePeriod
+	}
+	gracePeriod, ok := common.ShouldPatchInt(stateValue, plan.NodeDrainGracePeriod)
+	if !ok {
+		return nil
+	}
+	if cluster.Hypershift().Enabled() {
+		return fmt.Errorf("the node drain grace period is only supported for ROSA classic clusters")
+	}
+	if int64(cluster.NodeDrainGracePeriod().Value()) == gracePeriod {
+		return nil
+	}
+	clusterPatch, err := cmv1.NewCluster().
+		NodeDrainGracePeriod(cmv1.NewValue().Value(float64(gracePeriod)).Unit(nodeDrainGracePeriodUnit)).
+		Build()
+	if err != nil {
+		return err
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Updating node drain grace period of cluster '%s' to %d %s",
+		cluster.ID(), gracePeriod, nodeDrainGracePeriodUnit))
+	resp, err := r.collection.Cluster(cluster.ID()).Update().Body(clusterPatch).SendContext(ctx)
+	if err != nil {
+		return common.HandleErr(resp.Error(), err)
+	}
+	return nil
+}
+
+// populateState copies the data from the upgrade policy to the Terraform state.
+func populateState(policy *upgradePolicy, state *ClusterUpgradePolicyState) {
+	state.ID = types.StringValue(policy.ID)
+	state.ScheduleType = types.StringValue(string(policy.ScheduleType))
+	state.Schedule = common.EmptiableStringToStringType(policy.Schedule)
+	if policy.ScheduleType == cmv1.ScheduleTypeManual {
+		// Keep the version as written by the user, it may contain the version prefix
+		if strings.TrimPrefix(state.Version.ValueString(), rosa.VersionPrefix) != policy.Version {
+			state.Version = common.EmptiableStringToStringType(policy.Version)
+		}
+	} else {
+		state.Version = types.StringNull()
+	}
+	if policy.NextRun.IsZero() {
+		state.NextRun = types.StringNull()
+	} else {
+		// Keep the next run as written by the user when it is the same instant
+		current, err := time.Parse(time.RFC3339, state.NextRun.ValueString())
+		if err != nil || !current.Equal(policy.NextRun) {
+			state.NextRun = types.StringValue(policy.NextRun.UTC().Format(time.RFC3339))
+		}
+	}
+	state.State = common.EmptiableStringToStringType(string(policy.State))
+	state.StateDescription = common.EmptiableStringToStringType(policy.StateDescription)
+}
+
+// isManualUpgradeDone checks if the cluster already runs the version of the manual upgrade policy.
+func isManualUpgradeDone(state *ClusterUpgradePolicyState, cluster *cmv1.Cluster) bool {
+	if state.ScheduleType.ValueString() != string(cmv1.ScheduleTypeManual) || !common.HasValue(state.Version) {
+		return false
+	}
+	done, err := common.IsGreaterThanOrEqual(cluster.Version().RawID(), state.Version.ValueString())
+	return err == nil && done
+}
+
+func joinInt64s(values []int64) string {
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = fmt.Sprintf("%d", value)
+	}
+	return strings.Join(result, ", ")
+}
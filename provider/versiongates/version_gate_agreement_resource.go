@@ -0,0 +1,239 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versiongates
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type VersionGateAgreementResource struct {
+	collection *cmv1.ClustersClient
+}
+
+var _ resource.Resource = &VersionGateAgreementResource{}
+var _ resource.ResourceWithConfigure = &VersionGateAgreementResource{}
+var _ resource.ResourceWithImportState = &VersionGateAgreementResource{}
+
+func NewAgreementResource() resource.Resource {
+	return &VersionGateAgreementResource{}
+}
+
+func (r *VersionGateAgreementResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_version_gate_agreement"
+}
+
+func (r *VersionGateAgreementResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Agreement to a version gate of a cluster, required before upgrading the cluster " +
+			"to the versions of the gate. The gates that apply to an upgrade can be found with the " +
+			"'rhcs_version_gates' data source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the version gate agreement.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster": schema.StringAttribute{
+				Description: "Identifier of the cluster." + common.ValueCannotBeChangedStringDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "cluster ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version_gate_id": schema.StringAttribute{
+				Description: "Identifier of the version gate." + common.ValueCannotBeChangedStringDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`.*\S.*`), "version gate ID may not be empty/blank string"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"agreed_timestamp": schema.StringAttribute{
+				Description: "Date and time when the version gate was agreed to, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VersionGateAgreementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	connection, ok := req.ProviderData.(*sdk.Connection)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.Connaction, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.collection = connection.ClustersMgmt().V1().Clusters()
+}
+
+func (r *VersionGateAgreementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := &VersionGateAgreementState{}
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := plan.Cluster.ValueString()
+	gateID := plan.VersionGateID.ValueString()
+	agreement, err := cmv1.NewVersionGateAgreement().
+		VersionGate(cmv1.NewVersionGate().ID(gateID)).
+		Build()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't build version gate agreement",
+			fmt.Sprintf("Can't build agreement to version gate '%s' of cluster '%s': %v", gateID, clusterID, err),
+		)
+		return
+	}
+	tflog.Debug(ctx, "Agreeing to version gate", map[string]interface{}{
+		"cluster":      clusterID,
+		"version_gate": gateID,
+	})
+	add, err := r.collection.Cluster(clusterID).GateAgreements().Add().Body(agreement).SendContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Can't create version gate agreement",
+			fmt.Sprintf("Can't agree to version gate '%s' of cluster '%s': %v",
+				gateID, clusterID, common.HandleErr(add.Error(), err)),
+		)
+		return
+	}
+
+	populateAgreementState(add.Body(), plan)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *VersionGateAgreementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := &VersionGateAgreementState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	get, err := r.collection.Cluster(state.Cluster.ValueString()).GateAgreements().
+		VersionGateAgreement(state.ID.ValueString()).Get().SendContext(ctx)
+	if err != nil {
+		if get.Status() == http.StatusNotFound {
+			tflog.Warn(ctx, "version gate agreement not found, removing from state", map[string]interface{}{
+				"cluster": state.Cluster.ValueString(),
+				"id":      state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Can't find version gate agreement",
+			fmt.Sprintf("Can't find version gate agreement '%s' of cluster '%s': %v",
+				state.ID.ValueString(), state.Cluster.ValueString(), err),
+		)
+		return
+	}
+
+	populateAgreementState(get.Body(), state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *VersionGateAgreementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the attributes require replacing the agreement
+	resp.Diagnostics.AddError("Can't update version gate agreement", "Update is currently not supported.")
+}
+
+func (r *VersionGateAgreementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := &VersionGateAgreementState{}
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remove, err := r.collection.Cluster(state.Cluster.ValueString()).GateAgreements().
+		VersionGateAgreement(state.ID.ValueString()).Delete().SendContext(ctx)
+	if err != nil && remove.Status() != http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Can't delete version gate agreement",
+			fmt.Sprintf("Can't delete version gate agreement '%s' of cluster '%s': %v",
+				state.ID.ValueString(), state.Cluster.ValueString(), err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *VersionGateAgreementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// To import a version gate agreement, we need to know the cluster ID and the agreement ID
+	fields := strings.Split(req.ID, ",")
+	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			"Version gate agreement to import should be specified as <cluster_id>,<version_gate_agreement_id>",
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster"), fields[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fields[1])...)
+}
+
+// populateAgreementState copies the data from the version gate agreement to the Terraform state.
+func populateAgreementState(agreement *cmv1.VersionGateAgreement, state *VersionGateAgreementState) {
+	state.ID = types.StringValue(agreement.ID())
+	state.VersionGateID = types.StringValue(agreement.VersionGate().ID())
+	state.AgreedTimestamp = types.StringNull()
+	if agreedTimestamp, ok := agreement.GetAgreedTimestamp(); ok {
+		state.AgreedTimestamp = types.StringValue(agreedTimestamp.UTC().Format(time.RFC3339))
+	}
+}
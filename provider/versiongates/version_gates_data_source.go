@@ -0,0 +1,215 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versiongates
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	rosa "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
+)
+
+type VersionGatesDataSource struct {
+	collection *cmv1.VersionGatesClient
+}
+
+var _ datasource.DataSource = &VersionGatesDataSource{}
+var _ datasource.DataSourceWithConfigure = &VersionGatesDataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &VersionGatesDataSource{}
+}
+
+func (s *VersionGatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_version_gates"
+}
+
+func (s *VersionGatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of the version gates that apply to an upgrade between two OpenShift versions. " +
+			"The gates that aren't STS only need to be acknowledged before the upgrade, for example with " +
+			"the 'rhcs_version_gate_agreement' resource.",
+		Attributes: map[string]schema.Attribute{
+			"from_version": schema.StringAttribute{
+				Description: "Version to upgrade from, usually the current version of the cluster, for example '4.14.10'.",
+				Required:    true,
+			},
+			"to_version": schema.StringAttribute{
+				Description: "Version to upgrade to, for example '4.15.3'.",
+				Required:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Version gates of the minor versions crossed by the upgrade.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier of the version gate.",
+							Computed:    true,
+						},
+						"version_raw_id_prefix": schema.StringAttribute{
+							Description: "Versions that the gate applies to, for example '4.15'.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "Label of the version gate, for example 'api.openshift.com/gate-sts'.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "Value of the version gate label.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the version gate.",
+							Computed:    true,
+						},
+						"warning_message": schema.StringAttribute{
+							Description: "Warning message of the version gate.",
+							Computed:    true,
+						},
+						"documentation_url": schema.StringAttribute{
+							Description: "URL of the documentation of the version gate.",
+							Computed:    true,
+						},
+						"sts_only": schema.BoolAttribute{
+							Description: "Indicates if the version gate only applies to STS clusters. These gates " +
+								"are acknowledged automatically when the cluster resources schedule an upgrade.",
+							Computed: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (s *VersionGatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured:
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast the provider data to the specific implementation:
+	connection := req.ProviderData.(*sdk.Connection)
+
+	// Get the collection of version gates:
+	s.collection = connection.ClustersMgmt().V1().VersionGates()
+}
+
+func (s *VersionGatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get the state:
+	state := &VersionGatesState{}
+	diags := req.Config.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefixes, err := crossedMinorVersions(state.FromVersion.ValueString(), state.ToVersion.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid versions",
+			err.Error(),
+		)
+		return
+	}
+
+	// Fetch the version gates of the crossed minor versions:
+	state.Items = []*VersionGateState{}
+	if len(prefixes) == 0 {
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	listSize := 100
+	listPage := 1
+	listRequest := s.collection.List().Size(listSize).
+		Search(fmt.Sprintf("version_raw_id_prefix in ('%s')", strings.Join(prefixes, "','"))).
+		Order("version_raw_id_prefix asc")
+	for {
+		listResponse, err := listRequest.SendContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't list version gates",
+				err.Error(),
+			)
+			return
+		}
+		listResponse.Items().Each(func(gate *cmv1.VersionGate) bool {
+			state.Items = append(state.Items, versionGateToState(gate))
+			return true
+		})
+		if listResponse.Size() < listSize {
+			break
+		}
+		listPage++
+		listRequest.Page(listPage)
+	}
+
+	// Save the state:
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// crossedMinorVersions returns the minor versions, for example '4.15', that an upgrade between
+// the given versions goes through, excluding the minor version it starts from.
+func crossedMinorVersions(fromVersion, toVersion string) ([]string, error) {
+	from, err := semver.NewVersion(strings.TrimPrefix(fromVersion, rosa.VersionPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("can't parse version '%s': %v", fromVersion, err)
+	}
+	to, err := semver.NewVersion(strings.TrimPrefix(toVersion, rosa.VersionPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("can't parse version '%s': %v", toVersion, err)
+	}
+	if from.GreaterThan(to) {
+		return nil, fmt.Errorf("version '%s' is older than version '%s'", toVersion, fromVersion)
+	}
+	fromSegments := from.Segments()
+	toSegments := to.Segments()
+	if fromSegments[0] != toSegments[0] {
+		return nil, fmt.Errorf("upgrades between major versions '%d' and '%d' aren't supported",
+			fromSegments[0], toSegments[0])
+	}
+	prefixes := []string{}
+	for minor := fromSegments[1] + 1; minor <= toSegments[1]; minor++ {
+		prefixes = append(prefixes, fmt.Sprintf("%d.%d", toSegments[0], minor))
+	}
+	return prefixes, nil
+}
+
+func versionGateToState(gate *cmv1.VersionGate) *VersionGateState {
+	return &VersionGateState{
+		ID:                 types.StringValue(gate.ID()),
+		VersionRawIDPrefix: common.EmptiableStringToStringType(gate.VersionRawIDPrefix()),
+		Label:              common.EmptiableStringToStringType(gate.Label()),
+		Value:              common.EmptiableStringToStringType(gate.Value()),
+		Description:        common.EmptiableStringToStringType(gate.Description()),
+		WarningMessage:     common.EmptiableStringToStringType(gate.WarningMessage()),
+		DocumentationURL:   common.EmptiableStringToStringType(gate.DocumentationURL()),
+		STSOnly:            types.BoolValue(gate.STSOnly()),
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versiongates
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type VersionGatesState struct {
+	FromVersion types.String        `tfsdk:"from_version"`
+	ToVersion   types.String        `tfsdk:"to_version"`
+	Items       []*VersionGateState `tfsdk:"items"`
+}
+
+type VersionGateState struct {
+	ID                 types.String `tfsdk:"id"`
+	VersionRawIDPrefix types.String `tfsdk:"version_raw_id_prefix"`
+	Label              types.String `tfsdk:"label"`
+	Value              types.String `tfsdk:"value"`
+	Description        types.String `tfsdk:"description"`
+	WarningMessage     types.String `tfsdk:"warning_message"`
+	DocumentationURL   types.String `tfsdk:"documentation_url"`
+	STSOnly            types.Bool   `tfsdk:"sts_only"`
+}
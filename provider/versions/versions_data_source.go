@@ -18,12 +18,21 @@ package versions
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	rosaTypes "github.com/terraform-redhat/terraform-provider-rhcs/provider/clusterrosa/common/types"
+	"github.com/terraform-redhat/terraform-provider-rhcs/provider/common"
 )
 
 type VersionsDataSource struct {
@@ -53,6 +62,23 @@ func (s *VersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Description: "Order criteria.",
 				Optional:    true,
 			},
+			"version_constraint": schema.StringAttribute{
+				Description: "Only return the versions that satisfy this version constraint, " +
+					"for example '~> 4.15.0' for the z-stream releases of 4.15.",
+				Optional: true,
+			},
+			"topology": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return the versions that can be used for clusters of this topology, "+
+					"either '%s' (ROSA classic) or '%s' (ROSA with hosted control plane).", rosaTypes.Classic, rosaTypes.Hcp),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(rosaTypes.Classic), string(rosaTypes.Hcp)),
+				},
+			},
+			"channel_group": schema.StringAttribute{
+				Description: "Only return the versions of this channel group, for example 'stable' or 'fast'.",
+				Optional:    true,
+			},
 			"item": schema.SingleNestedAttribute{
 				Description: "Content of the list when there is exactly one item.",
 				Attributes:  s.itemAttributes(),
@@ -65,6 +91,11 @@ func (s *VersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				},
 				Computed: true,
 			},
+			"latest": schema.SingleNestedAttribute{
+				Description: "Highest version of the list, if any.",
+				Attributes:  s.itemAttributes(),
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -81,6 +112,51 @@ func (s *VersionsDataSource) itemAttributes() map[string]schema.Attribute {
 			Description: "Short name of the version, for example '4.1.0'.",
 			Computed:    true,
 		},
+		"channel_group": schema.StringAttribute{
+			Description: "Channel group of the version, for example 'stable'.",
+			Computed:    true,
+		},
+		"rosa_enabled": schema.BoolAttribute{
+			Description: "Indicates if the version can be used to create ROSA clusters.",
+			Computed:    true,
+		},
+		"hosted_control_plane_enabled": schema.BoolAttribute{
+			Description: "Indicates if the version can be used to create clusters with hosted control plane.",
+			Computed:    true,
+		},
+		"default": schema.BoolAttribute{
+			Description: "Indicates if this is the default version.",
+			Computed:    true,
+		},
+		"end_of_life_timestamp": schema.StringAttribute{
+			Description: "Date and time when the version stops being supported, in RFC3339 format.",
+			Computed:    true,
+		},
+		"available_upgrades": schema.ListAttribute{
+			Description: "Versions that this version can be upgraded to.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"ami_overrides": schema.ListNestedAttribute{
+			Description: "AWS machine images that override the default ones for this version.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"product": schema.StringAttribute{
+						Description: "Identifier of the product, for example 'rosa'.",
+						Computed:    true,
+					},
+					"region": schema.StringAttribute{
+						Description: "Cloud region identifier, for example 'us-east-1'.",
+						Computed:    true,
+					},
+					"ami": schema.StringAttribute{
+						Description: "Identifier of the AWS machine image.",
+						Computed:    true,
+					},
+				},
+			},
+			Computed: true,
+		},
 	}
 }
 
@@ -106,16 +182,25 @@ func (s *VersionsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	var constraints semver.Constraints
+	if common.HasValue(state.VersionConstraint) {
+		var err error
+		constraints, err = semver.NewConstraint(state.VersionConstraint.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid version constraint",
+				fmt.Sprintf("Can't parse version constraint '%s': %v", state.VersionConstraint.ValueString(), err),
+			)
+			return
+		}
+	}
+
 	// Fetch the list of versions:
 	var listItems []*cmv1.Version
 	listSize := 100
 	listPage := 1
 	listRequest := s.collection.List().Size(listSize)
-	if !state.Search.IsUnknown() && !state.Search.IsNull() {
-		listRequest.Search(state.Search.ValueString())
-	} else {
-		listRequest.Search("enabled = 't'")
-	}
+	listRequest.Search(buildSearch(state))
 	if !state.Order.IsUnknown() && !state.Order.IsNull() {
 		listRequest.Order(state.Order.ValueString())
 	}
@@ -132,7 +217,9 @@ func (s *VersionsDataSource) Read(ctx context.Context, req datasource.ReadReques
 			listItems = make([]*cmv1.Version, 0, listResponse.Total())
 		}
 		listResponse.Items().Each(func(listItem *cmv1.Version) bool {
-			listItems = append(listItems, listItem)
+			if constraints == nil || matchesConstraints(listItem, constraints) {
+				listItems = append(listItems, listItem)
+			}
 			return true
 		})
 		if listResponse.Size() < listSize {
@@ -145,18 +232,108 @@ func (s *VersionsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// Populate the state:
 	state.Items = make([]*VersionState, len(listItems))
 	for i, listItem := range listItems {
-		state.Items[i] = &VersionState{
-			ID:   types.StringValue(listItem.ID()),
-			Name: types.StringValue(listItem.RawID()),
+		item, err := versionToState(listItem)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Can't populate version state",
+				fmt.Sprintf("Can't populate state for version '%s': %v", listItem.ID(), err),
+			)
+			return
 		}
+		state.Items[i] = item
 	}
 	if len(state.Items) == 1 {
 		state.Item = state.Items[0]
 	} else {
 		state.Item = nil
 	}
+	state.Latest = nil
+	if latest := findLatest(listItems); latest >= 0 {
+		state.Latest = state.Items[latest]
+	}
 
 	// Save the state:
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// buildSearch calculates the search query sent to the server. The user supplied search defaults
+// to the enabled versions, and the topology and channel group filters are added to it.
+func buildSearch(state *VersionsState) string {
+	search := "enabled = 't'"
+	if common.HasValue(state.Search) {
+		search = state.Search.ValueString()
+	}
+	var filters []string
+	if common.HasValue(state.ChannelGroup) {
+		filters = append(filters, fmt.Sprintf("channel_group = '%s'", state.ChannelGroup.ValueString()))
+	}
+	if common.HasValue(state.Topology) {
+		switch rosaTypes.ClusterTopology(state.Topology.ValueString()) {
+		case rosaTypes.Classic:
+			filters = append(filters, "rosa_enabled = 't'")
+		case rosaTypes.Hcp:
+			filters = append(filters, "hosted_control_plane_enabled = 't'")
+		}
+	}
+	if len(filters) == 0 {
+		return search
+	}
+	if strings.TrimSpace(search) != "" {
+		filters = append([]string{fmt.Sprintf("(%s)", search)}, filters...)
+	}
+	return strings.Join(filters, " and ")
+}
+
+func matchesConstraints(version *cmv1.Version, constraints semver.Constraints) bool {
+	parsed, err := semver.NewVersion(version.RawID())
+	if err != nil {
+		return false
+	}
+	return constraints.Check(parsed)
+}
+
+// findLatest returns the index of the highest version of the list, or -1 if there is none.
+func findLatest(versions []*cmv1.Version) int {
+	latest := -1
+	var latestVersion *semver.Version
+	for i, version := range versions {
+		parsed, err := semver.NewVersion(version.RawID())
+		if err != nil {
+			continue
+		}
+		if latestVersion == nil || parsed.GreaterThan(latestVersion) {
+			latest = i
+			latestVersion = parsed
+		}
+	}
+	return latest
+}
+
+func versionToState(version *cmv1.Version) (*VersionState, error) {
+	result := &VersionState{
+		ID:                        types.StringValue(version.ID()),
+		Name:                      types.StringValue(version.RawID()),
+		ChannelGroup:              common.EmptiableStringToStringType(version.ChannelGroup()),
+		ROSAEnabled:               types.BoolValue(version.ROSAEnabled()),
+		HostedControlPlaneEnabled: types.BoolValue(version.HostedControlPlaneEnabled()),
+		Default:                   types.BoolValue(version.Default()),
+		EndOfLifeTimestamp:        types.StringNull(),
+	}
+	if endOfLife, ok := version.GetEndOfLifeTimestamp(); ok {
+		result.EndOfLifeTimestamp = types.StringValue(endOfLife.Format(time.RFC3339))
+	}
+	availableUpgrades, err := common.StringArrayToList(version.AvailableUpgrades())
+	if err != nil {
+		return nil, err
+	}
+	result.AvailableUpgrades = availableUpgrades
+	for _, override := range version.ImageOverrides().AWS() {
+		result.AMIOverrides = append(result.AMIOverrides, &AMIOverrideState{
+			Product: common.EmptiableStringToStringType(override.Product().ID()),
+			Region:  common.EmptiableStringToStringType(override.Region().ID()),
+			AMI:     types.StringValue(override.AMI()),
+		})
+	}
+	return result, nil
+}
@@ -19,13 +19,30 @@ package versions
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type VersionsState struct {
-	Search types.String    `tfsdk:"search"`
-	Order  types.String    `tfsdk:"order"`
-	Item   *VersionState   `tfsdk:"item"`
-	Items  []*VersionState `tfsdk:"items"`
+	Search            types.String    `tfsdk:"search"`
+	Order             types.String    `tfsdk:"order"`
+	VersionConstraint types.String    `tfsdk:"version_constraint"`
+	Topology          types.String    `tfsdk:"topology"`
+	ChannelGroup      types.String    `tfsdk:"channel_group"`
+	Item              *VersionState   `tfsdk:"item"`
+	Items             []*VersionState `tfsdk:"items"`
+	Latest            *VersionState   `tfsdk:"latest"`
 }
 
 type VersionState struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID                        types.String        `tfsdk:"id"`
+	Name                      types.String        `tfsdk:"name"`
+	ChannelGroup              types.String        `tfsdk:"channel_group"`
+	ROSAEnabled               types.Bool          `tfsdk:"rosa_enabled"`
+	HostedControlPlaneEnabled types.Bool          `tfsdk:"hosted_control_plane_enabled"`
+	Default                   types.Bool          `tfsdk:"default"`
+	EndOfLifeTimestamp        types.String        `tfsdk:"end_of_life_timestamp"`
+	AvailableUpgrades         types.List          `tfsdk:"available_upgrades"`
+	AMIOverrides              []*AMIOverrideState `tfsdk:"ami_overrides"`
+}
+
+type AMIOverrideState struct {
+	Product types.String `tfsdk:"product"`
+	Region  types.String `tfsdk:"region"`
+	AMI     types.String `tfsdk:"ami"`
 }
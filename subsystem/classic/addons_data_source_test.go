@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Add-ons data source", func() {
+	const addons = `{
+	  "page": 1,
+	  "size": 1,
+	  "total": 1,
+	  "items": [
+	    {
+	      "kind": "AddOn",
+	      "id": "my-addon",
+	      "name": "My add-on",
+	      "description": "Add-on used for testing.",
+	      "docs_link": "https://example.com/my-addon",
+	      "enabled": true,
+	      "install_mode": "own_namespace",
+	      "operator_name": "my-operator",
+	      "target_namespace": "my-namespace",
+	      "version": {
+	        "kind": "AddOnVersion",
+	        "id": "1.2.0"
+	      },
+	      "parameters": {
+	        "items": [
+	          {
+	            "id": "notification-email",
+	            "name": "Notification email",
+	            "value_type": "string",
+	            "required": true,
+	            "editable": true,
+	            "validation": "^.+@.+$"
+	          },
+	          {
+	            "id": "retention-days",
+	            "name": "Retention days",
+	            "value_type": "number",
+	            "default_value": "7",
+	            "options": [
+	              {
+	                "name": "One week",
+	                "value": "7"
+	              },
+	              {
+	                "name": "One month",
+	                "value": "30"
+	              }
+	            ]
+	          }
+	        ]
+	      },
+	      "requirements": [
+	        {
+	          "id": "my-requirement",
+	          "resource": "cluster",
+	          "enabled": true,
+	          "data": {
+	            "cloud_provider.id": "aws"
+	          }
+	        }
+	      ],
+	      "credentials_requests": [
+	        {
+	          "name": "my-operator-credentials",
+	          "namespace": "my-namespace",
+	          "service_account": "my-operator",
+	          "policy_permissions": [
+	            "s3:GetObject",
+	            "s3:PutObject"
+	          ]
+	        }
+	      ]
+	    }
+	  ]
+	}`
+
+	It("Lists the enabled add-ons by default", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/addons"),
+				VerifyFormKV("search", "enabled = 't'"),
+				RespondWithJSON(http.StatusOK, addons),
+			),
+		)
+
+		Terraform.Source(`
+		  data "rhcs_addons" "addons" {
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_addons", "addons")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 1))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "my-addon"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].version`, "1.2.0"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].install_mode`, "own_namespace"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].parameters | length`, 2))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].parameters[0].required`, true))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].parameters[1].default_value`, "7"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].parameters[1].options`, []interface{}{"7", "30"}))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].requirements[0].resource`, "cluster"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].requirements[0].data`, `{"cloud_provider.id":"aws"}`))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].required_operator_roles[0].service_account`, "my-operator"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].required_operator_roles[0].policy_permissions | length`, 2))
+	})
+
+	It("Uses the given search criteria", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/addons"),
+				VerifyFormKV("search", "id = 'my-addon'"),
+				RespondWithJSON(http.StatusOK, addons),
+			),
+		)
+
+		Terraform.Source(`
+		  data "rhcs_addons" "addons" {
+		    search = "id = 'my-addon'"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_addons", "addons")
+		Expect(resource).To(MatchJQ(`.attributes.items[0].name`, "My add-on"))
+	})
+})
@@ -0,0 +1,279 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Cluster add-on resource", func() {
+	const addonsRoute = "/api/clusters_mgmt/v1/clusters/123/addons"
+	const installation = `{
+	  "kind": "AddOnInstallation",
+	  "id": "my-addon",
+	  "addon": {
+	    "kind": "AddOnLink",
+	    "id": "my-addon"
+	  },
+	  "addon_version": {
+	    "kind": "AddOnVersion",
+	    "id": "1.2.0"
+	  },
+	  "parameters": {
+	    "items": [
+	      {
+	        "id": "notification-email",
+	        "value": "alice@example.com"
+	      },
+	      {
+	        "id": "retention-days",
+	        "value": "7"
+	      }
+	    ]
+	  },
+	  "state": "installing"
+	}`
+
+	createAddon := func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, addonsRoute),
+				VerifyJQ(".addon.id", "my-addon"),
+				VerifyJQ(".parameters.items | length", 1),
+				VerifyJQ(".parameters.items[0].id", "notification-email"),
+				VerifyJQ(".parameters.items[0].value", "alice@example.com"),
+				RespondWithJSON(http.StatusCreated, installation),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "addon" {
+		    cluster  = "123"
+		    addon_id = "my-addon"
+		    parameters = {
+		      "notification-email" = "alice@example.com"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+	}
+
+	It("Installs an add-on", func() {
+		createAddon()
+
+		resource := Terraform.Resource("rhcs_cluster_addon", "addon")
+		Expect(resource).To(MatchJQ(".attributes.id", "my-addon"))
+		Expect(resource).To(MatchJQ(".attributes.version", "1.2.0"))
+		Expect(resource).To(MatchJQ(".attributes.state", "installing"))
+		// Only the parameters that were set are tracked:
+		Expect(resource).To(MatchJQ(".attributes.parameters | length", 1))
+		Expect(resource).To(MatchJQ(`.attributes.parameters["notification-email"]`, "alice@example.com"))
+	})
+
+	It("Waits for the installation to complete", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, addonsRoute),
+				RespondWithJSON(http.StatusCreated, installation),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, addonsRoute+"/my-addon"),
+				RespondWithPatchedJSON(http.StatusOK, installation, `[
+				  {
+				    "op": "replace",
+				    "path": "/state",
+				    "value": "ready"
+				  }
+				]`),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "addon" {
+		    cluster                   = "123"
+		    addon_id                  = "my-addon"
+		    wait_for_install_complete = true
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_cluster_addon", "addon")
+		Expect(resource).To(MatchJQ(".attributes.state", "ready"))
+		Expect(resource).To(MatchJQ(".attributes.parameters", nil))
+	})
+
+	It("Fails when the installation fails", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, addonsRoute),
+				RespondWithJSON(http.StatusCreated, installation),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, addonsRoute+"/my-addon"),
+				RespondWithPatchedJSON(http.StatusOK, installation, `[
+				  {
+				    "op": "replace",
+				    "path": "/state",
+				    "value": "failed"
+				  },
+				  {
+				    "op": "add",
+				    "path": "/state_description",
+				    "value": "Not enough worker nodes"
+				  }
+				]`),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "addon" {
+		    cluster                   = "123"
+		    addon_id                  = "my-addon"
+		    wait_for_install_complete = true
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Not enough worker nodes")
+
+		// The failed installation is kept in the state, so that it is replaced by the next apply:
+		resource := Terraform.Resource("rhcs_cluster_addon", "addon")
+		Expect(resource).To(MatchJQ(".attributes.state", "failed"))
+	})
+
+	It("Fails if the add-on can't be installed", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, addonsRoute),
+				RespondWithJSON(http.StatusBadRequest, `{
+				  "kind": "Error",
+				  "id": "400",
+				  "href": "/api/clusters_mgmt/v1/errors/400",
+				  "code": "CLUSTERS-MGMT-400",
+				  "reason": "Add-on 'my-addon' requires parameter 'notification-email'"
+				}`),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "addon" {
+		    cluster  = "123"
+		    addon_id = "my-addon"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("requires parameter 'notification-email'")
+	})
+
+	It("Updates the parameters", func() {
+		createAddon()
+
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, addonsRoute+"/my-addon"),
+				RespondWithJSON(http.StatusOK, installation),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPatch, addonsRoute+"/my-addon"),
+				VerifyJQ(".parameters.items | length", 1),
+				VerifyJQ(".parameters.items[0].id", "notification-email"),
+				VerifyJQ(".parameters.items[0].value", "bob@example.com"),
+				RespondWithPatchedJSON(http.StatusOK, installation, `[
+				  {
+				    "op": "replace",
+				    "path": "/parameters/items/0/value",
+				    "value": "bob@example.com"
+				  }
+				]`),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "addon" {
+		    cluster  = "123"
+		    addon_id = "my-addon"
+		    parameters = {
+		      "notification-email" = "bob@example.com"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_cluster_addon", "addon")
+		Expect(resource).To(MatchJQ(`.attributes.parameters["notification-email"]`, "bob@example.com"))
+	})
+
+	It("Removes the add-on from the state when it isn't installed", func() {
+		createAddon()
+
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, addonsRoute+"/my-addon"),
+				RespondWithJSON(http.StatusNotFound, "{}"),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, addonsRoute),
+				RespondWithJSON(http.StatusCreated, installation),
+			),
+		)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+	})
+
+	It("Uninstalls the add-on", func() {
+		createAddon()
+
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, addonsRoute+"/my-addon"),
+				RespondWithJSON(http.StatusOK, installation),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodDelete, addonsRoute+"/my-addon"),
+				RespondWithJSON(http.StatusNoContent, "{}"),
+			),
+		)
+		runOutput := Terraform.Destroy()
+		Expect(runOutput.ExitCode).To(BeZero())
+	})
+
+	It("Imports an add-on installation", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, addonsRoute+"/my-addon"),
+				RespondWithJSON(http.StatusOK, installation),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_cluster_addon" "addon" {
+		    cluster  = "123"
+		    addon_id = "my-addon"
+		  }
+		`)
+		runOutput := Terraform.Import("rhcs_cluster_addon.addon", "123,my-addon")
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_cluster_addon", "addon")
+		Expect(resource).To(MatchJQ(".attributes.addon_id", "my-addon"))
+		Expect(resource).To(MatchJQ(".attributes.version", "1.2.0"))
+	})
+})
@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Cluster available upgrades data source", func() {
+	It("Lists the available upgrades of a classic cluster", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "123",
+				  "name": "my-cluster",
+				  "state": "ready",
+				  "version": {
+				    "id": "openshift-v4.14.0-fast",
+				    "raw_id": "4.14.0",
+				    "channel_group": "fast"
+				  }
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.0-fast"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "openshift-v4.14.0-fast",
+				  "raw_id": "4.14.0",
+				  "channel_group": "fast",
+				  "available_upgrades": ["4.14.1", "4.14.2"]
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+				VerifyFormKV("search", "id in ('openshift-v4.14.1-fast', 'openshift-v4.14.2-fast')"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 2,
+				  "total": 2,
+				  "items": [
+				    {
+				      "id": "openshift-v4.14.1-fast",
+				      "raw_id": "4.14.1",
+				      "rosa_enabled": true
+				    },
+				    {
+				      "id": "openshift-v4.14.2-fast",
+				      "raw_id": "4.14.2",
+				      "rosa_enabled": false
+				    }
+				  ]
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies", "dryRun=true"),
+				VerifyJQ(".version", "4.14.1"),
+				RespondWithJSON(http.StatusNoContent, ""),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_cluster_available_upgrades" "upgrades" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster_available_upgrades", "upgrades")
+		Expect(resource).To(MatchJQ(`.attributes.current_version`, "4.14.0"))
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 1))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "openshift-v4.14.1-fast"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].name`, "4.14.1"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].requires_acknowledgement`, false))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].version_gates | length`, 0))
+	})
+
+	It("Fails if cluster ID is empty", func() {
+		Terraform.Source(`
+		  data "rhcs_cluster_available_upgrades" "upgrades" {
+		    cluster = ""
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("cluster ID may not be empty/blank string")
+	})
+})
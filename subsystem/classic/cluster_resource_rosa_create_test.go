@@ -57,6 +57,10 @@ const versionListPage1 = `{
 }`
 
 var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
+	BeforeEach(func() {
+		RouteClusterOidcThumbprint()
+	})
+
 	// This is the cluster that will be returned by the server when asked to create or retrieve
 	// a cluster.
 	template := `{
@@ -633,6 +637,7 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 			resource := Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
 			Expect(resource).To(MatchJQ(".attributes.current_version", "openshift-4.8.0"))
 			Expect(resource).To(MatchJQ(".attributes.infra_id", "my-cluster-123"))
+			Expect(resource).To(MatchJQ(".attributes.sts.thumbprint", ClusterOidcThumbprint))
 		})
 
 		It("Creates basic cluster returned empty az list", func() {
@@ -2233,6 +2238,267 @@ var _ = Describe("rhcs_cluster_rosa_classic - create", func() {
 
 		})
 
+		Context("Test hibernation", func() {
+			const stsPatch = `{
+			  "op": "add",
+			  "path": "/aws",
+			  "value": {
+				  "ec2_metadata_http_tokens": "optional",
+				  "sts" : {
+					  "oidc_endpoint_url": "https://127.0.0.1",
+					  "thumbprint": "111111",
+					  "role_arn": "",
+					  "support_role_arn": "",
+					  "instance_iam_roles" : {
+						"master_role_arn" : "",
+						"worker_role_arn" : ""
+					  },
+					  "operator_role_prefix" : "test"
+				  }
+			  }
+			}`
+			clusterSource := func(hibernate string) string {
+				return fmt.Sprintf(`
+				  resource "rhcs_cluster_rosa_classic" "my_cluster" {
+					name           = "my-cluster"
+					cloud_region   = "us-west-1"
+					aws_account_id = "123456789012"
+					sts = {
+						operator_role_prefix = "test"
+						role_arn = "",
+						support_role_arn = "",
+						instance_iam_roles = {
+							master_role_arn = "",
+							worker_role_arn = "",
+						}
+					}
+					%s
+				  }`, hibernate)
+			}
+			clusterInState := func(state string) http.HandlerFunc {
+				return RespondWithPatchedJSON(http.StatusOK, template, `[`+stsPatch+`,
+				{
+				  "op": "replace",
+				  "path": "/state",
+				  "value": "`+state+`"
+				}]`)
+			}
+
+			It("Fails to hibernate on creation without waiting for the cluster", func() {
+				// The plan fails, without sending any request:
+				Terraform.Source(clusterSource("hibernate = true"))
+				runOutput := Terraform.Run("plan")
+				Expect(runOutput.ExitCode).ToNot(BeZero())
+				runOutput.VerifyErrorContainsSubstring("'hibernate' can only be `true` on creation")
+			})
+
+			It("Hibernates and resumes the cluster", func() {
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+						RespondWithJSON(http.StatusOK, versionListPage1),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+						RespondWithPatchedJSON(http.StatusCreated, template, `[`+stsPatch+`]`),
+					),
+				)
+				Terraform.Source(clusterSource(""))
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).To(BeZero())
+				resource := Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+				Expect(resource).To(MatchJQ(".attributes.hibernate", nil))
+
+				// Hibernate the cluster
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+						clusterInState("ready"),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+						clusterInState("ready"),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/hibernate"),
+						RespondWithJSON(http.StatusOK, "{}"),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+						clusterInState("hibernating"),
+					),
+				)
+				Terraform.Source(clusterSource("hibernate = true"))
+				runOutput = Terraform.Apply()
+				Expect(runOutput.ExitCode).To(BeZero())
+				resource = Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+				Expect(resource).To(MatchJQ(".attributes.hibernate", true))
+				Expect(resource).To(MatchJQ(".attributes.state", "hibernating"))
+
+				// Resume the cluster before applying the other changes
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+						clusterInState("hibernating"),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/resume"),
+						RespondWithJSON(http.StatusOK, "{}"),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+						clusterInState("ready"),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+						clusterInState("ready"),
+					),
+				)
+				Terraform.Source(clusterSource("hibernate = false"))
+				runOutput = Terraform.Apply()
+				Expect(runOutput.ExitCode).To(BeZero())
+				resource = Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+				Expect(resource).To(MatchJQ(".attributes.hibernate", false))
+				Expect(resource).To(MatchJQ(".attributes.state", "ready"))
+			})
+
+			It("Reports a cluster resumed outside of Terraform as drift", func() {
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+						RespondWithJSON(http.StatusOK, versionListPage1),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+						RespondWithPatchedJSON(http.StatusCreated, template, `[`+stsPatch+`]`),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+						clusterInState("ready"),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/hibernate"),
+						RespondWithJSON(http.StatusOK, "{}"),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+						clusterInState("hibernating"),
+					),
+				)
+				Terraform.Source(clusterSource("hibernate = true\nwait_for_create_complete = true"))
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).To(BeZero())
+				resource := Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+				Expect(resource).To(MatchJQ(".attributes.hibernate", true))
+
+				// The cluster was resumed outside of Terraform, so it's hibernated again
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+						clusterInState("ready"),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/api/clusters_mgmt/v1/clusters/123"),
+						clusterInState("ready"),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters/123/hibernate"),
+						RespondWithJSON(http.StatusOK, "{}"),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+						clusterInState("hibernating"),
+					),
+				)
+				runOutput = Terraform.Apply()
+				Expect(runOutput.ExitCode).To(BeZero())
+				resource = Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+				Expect(resource).To(MatchJQ(".attributes.state", "hibernating"))
+			})
+		})
+
+		It("Refuses to destroy or replace a cluster with delete protection", func() {
+			const stsPatch = `{
+			  "op": "add",
+			  "path": "/aws",
+			  "value": {
+				  "ec2_metadata_http_tokens": "optional",
+				  "sts" : {
+					  "oidc_endpoint_url": "https://127.0.0.1",
+					  "thumbprint": "111111",
+					  "role_arn": "",
+					  "support_role_arn": "",
+					  "instance_iam_roles" : {
+						"master_role_arn" : "",
+						"worker_role_arn" : ""
+					  },
+					  "operator_role_prefix" : "test"
+				  }
+			  }
+			}`
+			const protectionPatch = `{
+			  "op": "add",
+			  "path": "/delete_protection",
+			  "value": {
+				  "enabled": true
+			  }
+			}`
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					RespondWithJSON(http.StatusOK, versionListPage1),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+					VerifyJQ(`.delete_protection.enabled`, true),
+					RespondWithPatchedJSON(http.StatusCreated, template, `[`+stsPatch+`,`+protectionPatch+`]`),
+				),
+			)
+			Terraform.Source(`
+			  resource "rhcs_cluster_rosa_classic" "my_cluster" {
+				name           = "my-cluster"
+				cloud_region   = "us-west-1"
+				aws_account_id = "123456789012"
+				delete_protection = true
+				sts = {
+					operator_role_prefix = "test"
+					role_arn = "",
+					support_role_arn = "",
+					instance_iam_roles = {
+						master_role_arn = "",
+						worker_role_arn = "",
+					}
+				}
+			  }`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+			Expect(resource).To(MatchJQ(".attributes.delete_protection", true))
+
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithPatchedJSON(http.StatusOK, template, `[`+stsPatch+`,`+protectionPatch+`]`),
+				),
+			)
+			runOutput = Terraform.Destroy()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Cluster '123' has delete protection enabled and can't be destroyed")
+
+			// The replacement fails before the cluster is deleted or a new one is created
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithPatchedJSON(http.StatusOK, template, `[`+stsPatch+`,`+protectionPatch+`]`),
+				),
+			)
+			runOutput = Terraform.Run("apply", "-auto-approve", "-replace=rhcs_cluster_rosa_classic.my_cluster")
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Cluster '123' has delete protection enabled and can't be destroyed or replaced")
+			resource = Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+			Expect(resource).To(MatchJQ(".attributes.id", "123"))
+		})
+
 		Context("Test Proxy", func() {
 			It("Creates cluster with http proxy and update it", func() {
 				// Prepare the server:
@@ -27,6 +27,10 @@ import (
 )
 
 var _ = Describe("rhcs_cluster_rosa_classic - import", func() {
+	BeforeEach(func() {
+		RouteClusterOidcThumbprint()
+	})
+
 	const template = `{
 		"id": "123",
 		"name": "my-cluster",
@@ -131,5 +135,29 @@ var _ = Describe("rhcs_cluster_rosa_classic - import", func() {
 			Expect(resource).To(MatchJQ(".attributes.current_version", "4.10.0"))
 		})
 
+		It("imports the delete protection of the cluster", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+					RespondWithPatchedJSON(http.StatusOK, template, `[
+						{
+						  "op": "add",
+						  "path": "/delete_protection",
+						  "value": {
+							  "enabled": true
+						  }
+						}]`),
+				),
+			)
+
+			Terraform.Source(`
+			  resource "rhcs_cluster_rosa_classic" "my_cluster" { }
+			`)
+			runOutput := Terraform.Import("rhcs_cluster_rosa_classic.my_cluster", "123")
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+			Expect(resource).To(MatchJQ(".attributes.delete_protection", true))
+		})
+
 	})
 })
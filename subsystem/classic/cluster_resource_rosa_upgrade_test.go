@@ -18,6 +18,7 @@ package classic
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
@@ -28,6 +29,10 @@ import (
 )
 
 var _ = Describe("rhcs_cluster_rosa_classic - upgrade", func() {
+	BeforeEach(func() {
+		RouteClusterOidcThumbprint()
+	})
+
 	const template = `{
 		"id": "123",
 		"name": "my-cluster",
@@ -120,6 +125,13 @@ var _ = Describe("rhcs_cluster_rosa_classic - upgrade", func() {
 		"available_upgrades": [],
 		"rosa_enabled": true
 	}`
+	const v4_10_1List = `{
+		"kind": "VersionList",
+		"page": 1,
+		"size": 1,
+		"total": 1,
+		"items": [` + v4_10_1Info + `]
+	}`
 	const upgradePoliciesEmpty = `{
 		"kind": "UpgradePolicyList",
 		"page": 1,
@@ -227,14 +239,25 @@ var _ = Describe("rhcs_cluster_rosa_classic - upgrade", func() {
 					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
 					RespondWithJSON(http.StatusOK, template),
 				),
+				// Check for conflicting upgrade policies while planning
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, upgradePoliciesEmpty),
+				),
+				// And again when applying the plan
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, upgradePoliciesEmpty),
+				),
 				// Validate upgrade versions
 				CombineHandlers(
 					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.10.0"),
 					RespondWithJSON(http.StatusOK, v4_10_0Info),
 				),
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.10.1"),
-					RespondWithJSON(http.StatusOK, v4_10_1Info),
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.10.1')"),
+					RespondWithJSON(http.StatusOK, v4_10_1List),
 				),
 				// Look for existing upgrade policies
 				CombineHandlers(
@@ -353,14 +376,25 @@ var _ = Describe("rhcs_cluster_rosa_classic - upgrade", func() {
 					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
 					RespondWithJSON(http.StatusOK, template),
 				),
+				// Check for conflicting upgrade policies while planning
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, upgradePoliciesEmpty),
+				),
+				// And again when applying the plan
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, upgradePoliciesEmpty),
+				),
 				// Validate upgrade versions
 				CombineHandlers(
 					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.10.0"),
 					RespondWithJSON(http.StatusOK, v4_10_0Info),
 				),
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.10.1"),
-					RespondWithJSON(http.StatusOK, v4_10_1Info),
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.10.1')"),
+					RespondWithJSON(http.StatusOK, v4_10_1List),
 				),
 				// Look for existing upgrade policies
 				CombineHandlers(
@@ -478,14 +512,81 @@ var _ = Describe("rhcs_cluster_rosa_classic - upgrade", func() {
 					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
 					RespondWithJSON(http.StatusOK, template),
 				),
+				// Check for conflicting upgrade policies while planning
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+					"kind": "UpgradePolicyState",
+					"page": 1,
+					"size": 0,
+					"total": 0,
+					"items": [
+						{
+							"kind": "UpgradePolicy",
+							"id": "456",
+							"href": "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/123",
+							"schedule_type": "manual",
+							"upgrade_type": "OSD",
+							"version": "4.10.0",
+							"next_run": "2023-06-09T20:59:00Z",
+							"cluster_id": "123",
+							"enable_minor_version_upgrades": true
+						}
+					]
+				}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456/state"),
+					RespondWithJSON(http.StatusOK, `{
+					"kind": "UpgradePolicyState",
+					"id": "456",
+					"href": "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456/state",
+					"description": "Upgrade in progress",
+					"value": "started"
+				}`),
+				),
+				// And again when applying the plan
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+					"kind": "UpgradePolicyState",
+					"page": 1,
+					"size": 0,
+					"total": 0,
+					"items": [
+						{
+							"kind": "UpgradePolicy",
+							"id": "456",
+							"href": "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/123",
+							"schedule_type": "manual",
+							"upgrade_type": "OSD",
+							"version": "4.10.0",
+							"next_run": "2023-06-09T20:59:00Z",
+							"cluster_id": "123",
+							"enable_minor_version_upgrades": true
+						}
+					]
+				}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456/state"),
+					RespondWithJSON(http.StatusOK, `{
+					"kind": "UpgradePolicyState",
+					"id": "456",
+					"href": "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456/state",
+					"description": "Upgrade in progress",
+					"value": "started"
+				}`),
+				),
 				// Validate upgrade versions
 				CombineHandlers(
 					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.10.0"),
 					RespondWithJSON(http.StatusOK, v4_10_0Info),
 				),
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.10.1"),
-					RespondWithJSON(http.StatusOK, v4_10_1Info),
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.10.1')"),
+					RespondWithJSON(http.StatusOK, v4_10_1List),
 				),
 				// Look for existing upgrade policies
 				CombineHandlers(
@@ -550,14 +651,81 @@ var _ = Describe("rhcs_cluster_rosa_classic - upgrade", func() {
 					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
 					RespondWithJSON(http.StatusOK, template),
 				),
+				// Check for conflicting upgrade policies while planning
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+					"kind": "UpgradePolicyState",
+					"page": 1,
+					"size": 0,
+					"total": 0,
+					"items": [
+						{
+							"kind": "UpgradePolicy",
+							"id": "456",
+							"href": "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/123",
+							"schedule_type": "manual",
+							"upgrade_type": "OSD",
+							"version": "4.10.0",
+							"next_run": "2023-06-09T20:59:00Z",
+							"cluster_id": "123",
+							"enable_minor_version_upgrades": true
+						}
+					]
+				}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456/state"),
+					RespondWithJSON(http.StatusOK, `{
+					"kind": "UpgradePolicyState",
+					"id": "456",
+					"href": "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456/state",
+					"description": "",
+					"value": "scheduled"
+				}`),
+				),
+				// And again when applying the plan
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+					"kind": "UpgradePolicyState",
+					"page": 1,
+					"size": 0,
+					"total": 0,
+					"items": [
+						{
+							"kind": "UpgradePolicy",
+							"id": "456",
+							"href": "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/123",
+							"schedule_type": "manual",
+							"upgrade_type": "OSD",
+							"version": "4.10.0",
+							"next_run": "2023-06-09T20:59:00Z",
+							"cluster_id": "123",
+							"enable_minor_version_upgrades": true
+						}
+					]
+				}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456/state"),
+					RespondWithJSON(http.StatusOK, `{
+					"kind": "UpgradePolicyState",
+					"id": "456",
+					"href": "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456/state",
+					"description": "",
+					"value": "scheduled"
+				}`),
+				),
 				// Validate upgrade versions
 				CombineHandlers(
 					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.10.0"),
 					RespondWithJSON(http.StatusOK, v4_10_0Info),
 				),
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.10.1"),
-					RespondWithJSON(http.StatusOK, v4_10_1Info),
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.10.1')"),
+					RespondWithJSON(http.StatusOK, v4_10_1List),
 				),
 				// Look for existing upgrade policies
 				CombineHandlers(
@@ -657,6 +825,124 @@ var _ = Describe("rhcs_cluster_rosa_classic - upgrade", func() {
 			Expect(runOutput.ExitCode).To(BeZero())
 		})
 
+		Context("Upgrade conflict policy", func() {
+			const conflictingPolicies = `{
+				"kind": "UpgradePolicyList",
+				"page": 1,
+				"size": 1,
+				"total": 1,
+				"items": [
+					{
+						"kind": "UpgradePolicy",
+						"id": "456",
+						"href": "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456",
+						"schedule_type": "manual",
+						"upgrade_type": "OSD",
+						"version": "4.10.2",
+						"next_run": "2023-06-09T20:59:00Z",
+						"cluster_id": "123"
+					}
+				]
+			}`
+			const conflictingPolicyState = `{
+				"kind": "UpgradePolicyState",
+				"id": "456",
+				"href": "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456/state",
+				"description": "Upgrade scheduled by an SRE",
+				"value": "scheduled"
+			}`
+			source := func(conflictPolicy string) string {
+				return fmt.Sprintf(`
+				resource "rhcs_cluster_rosa_classic" "my_cluster" {
+					name           = "my-cluster"
+					cloud_region   = "us-west-1"
+					aws_account_id = "123456789012"
+					sts = {
+						operator_role_prefix = "test"
+						role_arn = ""
+						support_role_arn = ""
+						instance_iam_roles = {
+							master_role_arn = ""
+							worker_role_arn = ""
+						}
+					}
+					version = "4.10.1"
+					upgrade_conflict_policy = "%s"
+				}`, conflictPolicy)
+			}
+			// Refresh the cluster state and look for conflicting upgrade policies while planning,
+			// and then again while applying, followed by the validation of the upgrade
+			prepareUpgrade := func() {
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+						RespondWithJSON(http.StatusOK, template),
+					),
+				)
+				for i := 0; i < 2; i++ {
+					TestServer.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+							RespondWithJSON(http.StatusOK, conflictingPolicies),
+						),
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456/state"),
+							RespondWithJSON(http.StatusOK, conflictingPolicyState),
+						),
+					)
+				}
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.10.0"),
+						RespondWithJSON(http.StatusOK, v4_10_0Info),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+						VerifyFormKV("search", "id in ('openshift-v4.10.1')"),
+						RespondWithJSON(http.StatusOK, v4_10_1List),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, conflictingPolicies),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies/456/state"),
+						RespondWithJSON(http.StatusOK, conflictingPolicyState),
+					),
+				)
+			}
+
+			It("Fails if the conflict policy is invalid", func() {
+				Terraform.Source(source("ignore"))
+				runOutput := Terraform.Validate()
+				Expect(runOutput.ExitCode).ToNot(BeZero())
+				runOutput.VerifyErrorContainsSubstring(`Attribute upgrade_conflict_policy value must be one of`)
+			})
+
+			It("Fails the upgrade if there are conflicting upgrades", func() {
+				prepareUpgrade()
+				Terraform.Source(source("fail"))
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).ToNot(BeZero())
+				runOutput.VerifyOutputContainsSubstring("Conflicting upgrades")
+				runOutput.VerifyErrorContainsSubstring("upgrade policy '456' to version '4.10.2'")
+			})
+
+			It("Keeps the conflicting upgrades and doesn't record the requested version", func() {
+				prepareUpgrade()
+				Terraform.Source(source("keep"))
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).ToNot(BeZero())
+				runOutput.VerifyOutputContainsSubstring("Conflicting upgrades")
+				runOutput.VerifyErrorContainsSubstring("upgrade to version '4.10.1' can't be scheduled")
+				runOutput.VerifyErrorContainsSubstring("upgrade policy '456' to version '4.10.2'")
+
+				// The version bump stays pending, so it is requested again by the next apply:
+				resource := Terraform.Resource("rhcs_cluster_rosa_classic", "my_cluster")
+				Expect(resource).To(MatchJQ(".attributes.version", "4.10.0"))
+			})
+		})
+
 		It("Cancels upgrade if version=current_version", func() {
 			TestServer.AppendHandlers(
 				// Refresh cluster state
@@ -812,14 +1098,25 @@ var _ = Describe("rhcs_cluster_rosa_classic - upgrade", func() {
 						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
 						RespondWithJSON(http.StatusOK, template),
 					),
+					// Check for conflicting upgrade policies while planning
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, upgradePoliciesEmpty),
+					),
+					// And again when applying the plan
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, upgradePoliciesEmpty),
+					),
 					// Validate upgrade versions
 					CombineHandlers(
 						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.10.0"),
 						RespondWithJSON(http.StatusOK, v4_10_0Info),
 					),
 					CombineHandlers(
-						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.10.1"),
-						RespondWithJSON(http.StatusOK, v4_10_1Info),
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+						VerifyFormKV("search", "id in ('openshift-v4.10.1')"),
+						RespondWithJSON(http.StatusOK, v4_10_1List),
 					),
 					// Look for existing upgrade policies
 					CombineHandlers(
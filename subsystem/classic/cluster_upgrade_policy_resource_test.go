@@ -0,0 +1,401 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Cluster upgrade policy resource", func() {
+	const clusterRoute = "/api/clusters_mgmt/v1/clusters/123"
+	const clusterReady = `{
+	  "id": "123",
+	  "name": "my-cluster",
+	  "state": "ready",
+	  "version": {
+	    "id": "openshift-v4.15.2",
+	    "raw_id": "4.15.2"
+	  },
+	  "node_drain_grace_period": {
+	    "value": 60,
+	    "unit": "minutes"
+	  }
+	}`
+	const automaticPolicy = `{
+	  "id": "456",
+	  "schedule_type": "automatic",
+	  "schedule": "0 2 * * 6",
+	  "upgrade_type": "OSD",
+	  "next_run": "2024-06-01T02:00:00Z",
+	  "cluster_id": "123"
+	}`
+	const manualPolicy = `{
+	  "id": "789",
+	  "schedule_type": "manual",
+	  "upgrade_type": "OSD",
+	  "version": "4.15.3",
+	  "next_run": "2024-06-01T02:00:00Z",
+	  "cluster_id": "123"
+	}`
+	const scheduledState = `{
+	  "value": "scheduled",
+	  "description": "Upgrade scheduled."
+	}`
+
+	It("Fails if the automatic policy doesn't have a schedule", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster_upgrade_policy" "policy" {
+		    cluster       = "123"
+		    schedule_type = "automatic"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Attribute 'schedule' is required when 'schedule_type' is 'automatic'")
+	})
+
+	It("Fails if the automatic policy has a version", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster_upgrade_policy" "policy" {
+		    cluster       = "123"
+		    schedule_type = "automatic"
+		    schedule      = "0 2 * * 6"
+		    version       = "4.15.3"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Attribute 'version' can only be set when 'schedule_type' is 'manual'")
+	})
+
+	It("Fails if the manual policy doesn't have a version", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster_upgrade_policy" "policy" {
+		    cluster       = "123"
+		    schedule_type = "manual"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Attribute 'version' is required when 'schedule_type' is 'manual'")
+	})
+
+	It("Fails if the schedule isn't a cron expression", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster_upgrade_policy" "policy" {
+		    cluster       = "123"
+		    schedule_type = "automatic"
+		    schedule      = "every saturday"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("must be a cron expression with five fields")
+	})
+
+	It("Fails if the next run isn't in RFC3339 format", func() {
+		Terraform.Source(`
+		  resource "rhcs_cluster_upgrade_policy" "policy" {
+		    cluster       = "123"
+		    schedule_type = "manual"
+		    version       = "4.15.3"
+		    next_run      = "2024-06-01 02:00"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("it should be in RFC3339 format")
+	})
+
+	It("Creates an automatic upgrade policy with a node drain grace period", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterRoute),
+				RespondWithJSON(http.StatusOK, clusterReady),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPatch, clusterRoute),
+				VerifyJQ(".node_drain_grace_period.value", 120.0),
+				VerifyJQ(".node_drain_grace_period.unit", "minutes"),
+				RespondWithJSON(http.StatusOK, clusterReady),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, clusterRoute+"/upgrade_policies"),
+				VerifyJQ(".schedule_type", "automatic"),
+				VerifyJQ(".schedule", "0 2 * * 6"),
+				VerifyJQ(".version", nil),
+				VerifyJQ(".next_run", nil),
+				RespondWithJSON(http.StatusCreated, automaticPolicy),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/456"),
+				RespondWithJSON(http.StatusOK, automaticPolicy),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/456/state"),
+				RespondWithJSON(http.StatusOK, scheduledState),
+			),
+		)
+
+		Terraform.Source(`
+		  resource "rhcs_cluster_upgrade_policy" "policy" {
+		    cluster                 = "123"
+		    schedule_type           = "automatic"
+		    schedule                = "0 2 * * 6"
+		    node_drain_grace_period = 120
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_cluster_upgrade_policy", "policy")
+		Expect(resource).To(MatchJQ(`.attributes.id`, "456"))
+		Expect(resource).To(MatchJQ(`.attributes.schedule_type`, "automatic"))
+		Expect(resource).To(MatchJQ(`.attributes.schedule`, "0 2 * * 6"))
+		Expect(resource).To(MatchJQ(`.attributes.version`, nil))
+		Expect(resource).To(MatchJQ(`.attributes.next_run`, "2024-06-01T02:00:00Z"))
+		Expect(resource).To(MatchJQ(`.attributes.node_drain_grace_period`, 120.0))
+		Expect(resource).To(MatchJQ(`.attributes.state`, "scheduled"))
+		Expect(resource).To(MatchJQ(`.attributes.state_description`, "Upgrade scheduled."))
+	})
+
+	Context("Existing automatic upgrade policy", func() {
+		BeforeEach(func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute),
+					RespondWithJSON(http.StatusOK, clusterReady),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, clusterRoute+"/upgrade_policies"),
+					RespondWithJSON(http.StatusCreated, automaticPolicy),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, automaticPolicy),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/456/state"),
+					RespondWithJSON(http.StatusOK, scheduledState),
+				),
+			)
+
+			Terraform.Source(`
+			  resource "rhcs_cluster_upgrade_policy" "policy" {
+			    cluster       = "123"
+			    schedule_type = "automatic"
+			    schedule      = "0 2 * * 6"
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+
+		It("Updates the schedule", func() {
+			TestServer.AppendHandlers(
+				// Refresh
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute),
+					RespondWithJSON(http.StatusOK, clusterReady),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, automaticPolicy),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/456/state"),
+					RespondWithJSON(http.StatusOK, scheduledState),
+				),
+				// Update
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute),
+					RespondWithJSON(http.StatusOK, clusterReady),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, clusterRoute+"/upgrade_policies/456"),
+					VerifyJQ(".schedule", "30 1 * * 0"),
+					RespondWithJSON(http.StatusOK, "{}"),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, `{
+					  "id": "456",
+					  "schedule_type": "automatic",
+					  "schedule": "30 1 * * 0",
+					  "next_run": "2024-06-02T01:30:00Z",
+					  "cluster_id": "123"
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/456/state"),
+					RespondWithJSON(http.StatusOK, scheduledState),
+				),
+			)
+
+			Terraform.Source(`
+			  resource "rhcs_cluster_upgrade_policy" "policy" {
+			    cluster       = "123"
+			    schedule_type = "automatic"
+			    schedule      = "30 1 * * 0"
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			resource := Terraform.Resource("rhcs_cluster_upgrade_policy", "policy")
+			Expect(resource).To(MatchJQ(`.attributes.schedule`, "30 1 * * 0"))
+			Expect(resource).To(MatchJQ(`.attributes.next_run`, "2024-06-02T01:30:00Z"))
+		})
+
+		It("Removes the policy from the state if it was deleted", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute),
+					RespondWithJSON(http.StatusOK, clusterReady),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/456"),
+					RespondWithJSON(http.StatusNotFound, `{
+					  "kind": "Error",
+					  "id": "404",
+					  "href": "/api/clusters_mgmt/v1/errors/404",
+					  "code": "CLUSTERS-MGMT-404",
+					  "reason": "Upgrade policy with id '456' not found."
+					}`),
+				),
+			)
+
+			runOutput := Terraform.Run("plan", "-refresh-only", "-detailed-exitcode")
+			Expect(runOutput.ExitCode).To(Equal(2))
+		})
+
+		It("Deletes the policy", func() {
+			TestServer.AppendHandlers(
+				// Refresh
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute),
+					RespondWithJSON(http.StatusOK, clusterReady),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, automaticPolicy),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/456/state"),
+					RespondWithJSON(http.StatusOK, scheduledState),
+				),
+				// Delete
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute),
+					RespondWithJSON(http.StatusOK, clusterReady),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, clusterRoute+"/upgrade_policies/456"),
+					RespondWithJSON(http.StatusNoContent, ""),
+				),
+			)
+
+			runOutput := Terraform.Destroy()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+	})
+
+	Context("Manual upgrade policy", func() {
+		BeforeEach(func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute),
+					RespondWithJSON(http.StatusOK, clusterReady),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, clusterRoute+"/upgrade_policies"),
+					VerifyJQ(".schedule_type", "manual"),
+					VerifyJQ(".version", "4.15.3"),
+					VerifyJQ(".next_run", "2024-06-01T04:00:00+02:00"),
+					VerifyJQ(".schedule", nil),
+					RespondWithJSON(http.StatusCreated, manualPolicy),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/789"),
+					RespondWithJSON(http.StatusOK, manualPolicy),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/789/state"),
+					RespondWithJSON(http.StatusOK, scheduledState),
+				),
+			)
+
+			Terraform.Source(`
+			  resource "rhcs_cluster_upgrade_policy" "policy" {
+			    cluster       = "123"
+			    schedule_type = "manual"
+			    version       = "4.15.3"
+			    next_run      = "2024-06-01T04:00:00+02:00"
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+
+		It("Keeps the next run as written by the user", func() {
+			resource := Terraform.Resource("rhcs_cluster_upgrade_policy", "policy")
+			Expect(resource).To(MatchJQ(`.attributes.id`, "789"))
+			Expect(resource).To(MatchJQ(`.attributes.version`, "4.15.3"))
+			Expect(resource).To(MatchJQ(`.attributes.next_run`, "2024-06-01T04:00:00+02:00"))
+			Expect(resource).To(MatchJQ(`.attributes.schedule`, nil))
+		})
+
+		It("Keeps the policy once the upgrade is done", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute),
+					RespondWithJSON(http.StatusOK, `{
+					  "id": "123",
+					  "state": "ready",
+					  "version": {
+					    "id": "openshift-v4.15.3",
+					    "raw_id": "4.15.3"
+					  }
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, clusterRoute+"/upgrade_policies/789"),
+					RespondWithJSON(http.StatusNotFound, `{
+					  "kind": "Error",
+					  "id": "404",
+					  "href": "/api/clusters_mgmt/v1/errors/404",
+					  "code": "CLUSTERS-MGMT-404",
+					  "reason": "Upgrade policy with id '789' not found."
+					}`),
+				),
+			)
+
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			resource := Terraform.Resource("rhcs_cluster_upgrade_policy", "policy")
+			Expect(resource).To(MatchJQ(`.attributes.id`, "789"))
+			Expect(resource).To(MatchJQ(`.attributes.state`, "completed"))
+		})
+	})
+})
@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Clusters data source", func() {
+	It("Can list clusters", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 2,
+				  "total": 2,
+				  "items": [
+				    {
+				      "id": "123",
+				      "name": "my-classic",
+				      "external_id": "ext-123",
+				      "state": "ready",
+				      "region": {
+				        "id": "us-east-1"
+				      },
+				      "api": {
+				        "url": "https://api.my-classic.com:6443"
+				      },
+				      "console": {
+				        "url": "https://console.my-classic.com"
+				      },
+				      "version": {
+				        "id": "openshift-v4.14.10",
+				        "raw_id": "4.14.10"
+				      }
+				    },
+				    {
+				      "id": "456",
+				      "name": "my-hcp",
+				      "state": "installing",
+				      "region": {
+				        "id": "us-west-2"
+				      },
+				      "hypershift": {
+				        "enabled": true
+				      },
+				      "version": {
+				        "id": "openshift-v4.15.2-candidate",
+				        "channel_group": "candidate"
+				      }
+				    }
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_clusters" "my_clusters" {
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_clusters", "my_clusters")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 2))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "123"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].name`, "my-classic"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].external_id`, "ext-123"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].state`, "ready"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].version`, "4.14.10"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].region`, "us-east-1"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].topology`, "classic"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].api_url`, "https://api.my-classic.com:6443"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].console_url`, "https://console.my-classic.com"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].id`, "456"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].external_id`, nil))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].state`, "installing"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version`, "4.15.2"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].topology`, "hcp"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].api_url`, nil))
+	})
+
+	It("Can search clusters", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters"),
+				VerifyFormKV("search", "region.id = 'us-east-1'"),
+				VerifyFormKV("order", "name desc"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 1,
+				  "total": 1,
+				  "items": [
+				    {
+				      "id": "123",
+				      "name": "my-classic",
+				      "state": "ready",
+				      "region": {
+				        "id": "us-east-1"
+				      }
+				    }
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_clusters" "my_clusters" {
+		    search = "region.id = 'us-east-1'"
+		    order  = "name desc"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_clusters", "my_clusters")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 1))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "123"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].region`, "us-east-1"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].version`, nil))
+	})
+})
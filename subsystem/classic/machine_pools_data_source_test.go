@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Machine pools data source", func() {
+	const machinePools = `{
+	  "page": 1,
+	  "size": 3,
+	  "total": 3,
+	  "items": [
+	    {
+	      "id": "worker",
+	      "instance_type": "r5.xlarge",
+	      "replicas": 3,
+	      "availability_zones": ["us-east-1a", "us-east-1b", "us-east-1c"]
+	    },
+	    {
+	      "id": "infra",
+	      "instance_type": "m5.xlarge",
+	      "autoscaling": {
+	        "min_replicas": 1,
+	        "max_replicas": 3
+	      },
+	      "labels": {
+	        "role": "infra",
+	        "team": "platform"
+	      },
+	      "availability_zones": ["us-east-1a"]
+	    },
+	    {
+	      "id": "gpu",
+	      "instance_type": "p3.2xlarge",
+	      "replicas": 1,
+	      "labels": {
+	        "role": "gpu"
+	      },
+	      "availability_zones": ["us-east-1b"]
+	    }
+	  ]
+	}`
+
+	prepareServer := func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "123",
+				  "name": "my-cluster",
+				  "state": "ready"
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/machine_pools"),
+				RespondWithJSON(http.StatusOK, machinePools),
+			),
+		)
+	}
+
+	It("fails if cluster ID is empty", func() {
+		Terraform.Source(`
+		  data "rhcs_machine_pools" "my_pools" {
+		    cluster = ""
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Attribute cluster cluster ID may not be empty/blank string")
+	})
+
+	It("Can list all machine pools", func() {
+		prepareServer()
+
+		Terraform.Source(`
+		  data "rhcs_machine_pools" "my_pools" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_machine_pools", "my_pools")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 3))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "worker"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].replicas`, 3.0))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].autoscaling_enabled`, false))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].multi_availability_zone`, true))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].id`, "infra"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].autoscaling_enabled`, true))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].min_replicas`, 1.0))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].max_replicas`, 3.0))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].availability_zone`, "us-east-1a"))
+		Expect(resource).To(MatchJQ(`.attributes.items[2].id`, "gpu"))
+		Expect(resource).To(MatchJQ(`.attributes.items[2].machine_type`, "p3.2xlarge"))
+	})
+
+	It("Can filter machine pools by labels", func() {
+		prepareServer()
+
+		Terraform.Source(`
+		  data "rhcs_machine_pools" "my_pools" {
+		    cluster = "123"
+		    labels = {
+		      "role" = "infra"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_machine_pools", "my_pools")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 1))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "infra"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].labels.team`, "platform"))
+	})
+
+	It("Can filter machine pools by machine type, availability zone and autoscaling", func() {
+		prepareServer()
+
+		Terraform.Source(`
+		  data "rhcs_machine_pools" "my_pools" {
+		    cluster             = "123"
+		    machine_type        = "p3.2xlarge"
+		    availability_zone   = "us-east-1b"
+		    autoscaling_enabled = false
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_machine_pools", "my_pools")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 1))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "gpu"))
+	})
+})
@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("OIDC configs data source", func() {
+	const listOidcConfigsURL = "/api/clusters_mgmt/v1/oidc_configs"
+
+	const oidcConfigsList = `{
+	  "kind": "OidcConfigList",
+	  "page": 1,
+	  "size": 2,
+	  "total": 2,
+	  "items": [
+	    {
+	      "id": "23f6gk51qi5ng15mm095c90hhajbf7c5",
+	      "issuer_url": "https://d3gt1gce2zmg3d.cloudfront.net/23f6gk51qi5ng15mm095c90hhajbf7c5",
+	      "managed": true,
+	      "reusable": true
+	    },
+	    {
+	      "id": "2a4s5gqbqp3ob7bb0lbbsgoshmfhd8bf",
+	      "issuer_url": "https://oidc-f3y4.s3.us-east-1.amazonaws.com",
+	      "installer_role_arn": "arn:aws:iam::765374464689:role/terr-account2-Installer-Role",
+	      "secret_arn": "arn:aws:secretsmanager:us-east-1:765374464689:secret:rosa-private-key-oidc-f3y4-fEqj4c",
+	      "managed": false,
+	      "reusable": true
+	    }
+	  ]
+	}`
+
+	BeforeEach(func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, listOidcConfigsURL),
+				RespondWithJSON(http.StatusOK, oidcConfigsList),
+			),
+		)
+	})
+
+	It("Lists all the OIDC configs", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, getOidcConfigThumbprintURL),
+				VerifyJQ(".oidc_config_id", "23f6gk51qi5ng15mm095c90hhajbf7c5"),
+				RespondWithJSON(http.StatusOK, oidcConfigThumbprint),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, getOidcConfigThumbprintURL),
+				VerifyJQ(".oidc_config_id", "2a4s5gqbqp3ob7bb0lbbsgoshmfhd8bf"),
+				RespondWithJSON(http.StatusOK, `{
+				  "thumbprint": "a9d53002e97e00e043244f3d170d6f4c414104fd",
+				  "oidc_config_id": "2a4s5gqbqp3ob7bb0lbbsgoshmfhd8bf"
+				}`),
+			),
+		)
+		Terraform.Source(`
+		  data "rhcs_rosa_oidc_configs" "configs" {
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_rosa_oidc_configs", "configs")
+		Expect(resource).To(MatchJQ(".attributes.items | length", 2))
+		Expect(resource).To(MatchJQ(".attributes.items[0].oidc_endpoint_url", managedOidcEndpointURL))
+		Expect(resource).To(MatchJQ(".attributes.items[0].thumbprint", "9e99a48a9960b14926bb7f3b02e22da2b0ab7280"))
+		Expect(resource).To(MatchJQ(".attributes.items[0].managed", true))
+		Expect(resource).To(MatchJQ(".attributes.items[0].secret_arn", nil))
+		Expect(resource).To(MatchJQ(".attributes.items[1].oidc_endpoint_url", unManagedOidcEndpointURL))
+		Expect(resource).To(MatchJQ(".attributes.items[1].thumbprint", "a9d53002e97e00e043244f3d170d6f4c414104fd"))
+		Expect(resource).To(MatchJQ(".attributes.items[1].installer_role_arn", installerRoleARN))
+		Expect(resource).To(MatchJQ(".attributes.items[1].secret_arn", secretARN))
+	})
+
+	It("Lists only the managed OIDC configs", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, getOidcConfigThumbprintURL),
+				VerifyJQ(".oidc_config_id", "23f6gk51qi5ng15mm095c90hhajbf7c5"),
+				RespondWithJSON(http.StatusOK, oidcConfigThumbprint),
+			),
+		)
+		Terraform.Source(`
+		  data "rhcs_rosa_oidc_configs" "configs" {
+		    managed = true
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_rosa_oidc_configs", "configs")
+		Expect(resource).To(MatchJQ(".attributes.items | length", 1))
+		Expect(resource).To(MatchJQ(".attributes.items[0].id", "23f6gk51qi5ng15mm095c90hhajbf7c5"))
+	})
+
+	It("Finds the OIDC config by the OIDC endpoint URL", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, getOidcConfigThumbprintURL),
+				VerifyJQ(".oidc_config_id", "2a4s5gqbqp3ob7bb0lbbsgoshmfhd8bf"),
+				RespondWithJSON(http.StatusOK, `{
+				  "thumbprint": "a9d53002e97e00e043244f3d170d6f4c414104fd",
+				  "oidc_config_id": "2a4s5gqbqp3ob7bb0lbbsgoshmfhd8bf"
+				}`),
+			),
+		)
+		Terraform.Source(`
+		  data "rhcs_rosa_oidc_configs" "configs" {
+		    issuer_url = "` + unManagedOidcEndpointURL + `"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_rosa_oidc_configs", "configs")
+		Expect(resource).To(MatchJQ(".attributes.items | length", 1))
+		Expect(resource).To(MatchJQ(".attributes.items[0].id", "2a4s5gqbqp3ob7bb0lbbsgoshmfhd8bf"))
+	})
+
+	It("Returns an empty list when no OIDC config matches the identifier", func() {
+		Terraform.Source(`
+		  data "rhcs_rosa_oidc_configs" "configs" {
+		    id = "does-not-exist"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_rosa_oidc_configs", "configs")
+		Expect(resource).To(MatchJQ(".attributes.items | length", 0))
+	})
+})
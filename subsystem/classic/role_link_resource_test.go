@@ -0,0 +1,367 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Role link resources", func() {
+	const (
+		currentAccountRoute = "/api/accounts_mgmt/v1/current_account"
+		orgLabelsRoute      = "/api/accounts_mgmt/v1/organizations/org-123/labels"
+		userLabelsRoute     = "/api/accounts_mgmt/v1/accounts/acc-123/labels"
+		ocmRoleArn          = "arn:aws:iam::123456789012:role/ManagedOpenShift-OCM-Role-12345"
+		userRoleArn         = "arn:aws:iam::123456789012:role/ManagedOpenShift-User-jdoe-Role"
+		otherRoleArn        = "arn:aws:iam::210987654321:role/ManagedOpenShift-OCM-Role-12345"
+	)
+
+	const currentAccount = `{
+	  "kind": "Account",
+	  "id": "acc-123",
+	  "username": "jdoe",
+	  "organization": {
+	    "kind": "Organization",
+	    "id": "org-123",
+	    "external_id": "12345"
+	  }
+	}`
+
+	BeforeEach(func() {
+		// The current account is retrieved while planning, which may happen more than once:
+		TestServer.RouteToHandler(http.MethodGet, currentAccountRoute, RespondWithJSON(http.StatusOK, currentAccount))
+	})
+
+	Context("OCM role link", func() {
+		linkOcmRole := func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, orgLabelsRoute+"/sts_ocm_role"),
+					RespondWithJSON(http.StatusNotFound, "{}"),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, orgLabelsRoute),
+					VerifyJQ(".key", "sts_ocm_role"),
+					VerifyJQ(".value", ocmRoleArn),
+					RespondWithJSON(http.StatusCreated, `{
+					  "key": "sts_ocm_role",
+					  "value": "`+ocmRoleArn+`"
+					}`),
+				),
+			)
+			Terraform.Source(`
+			  resource "rhcs_ocm_role_link" "link" {
+			    role_arn = "` + ocmRoleArn + `"
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+		}
+
+		It("Links the role to the organization of the current account", func() {
+			linkOcmRole()
+
+			resource := Terraform.Resource("rhcs_ocm_role_link", "link")
+			Expect(resource).To(MatchJQ(".attributes.id", ocmRoleArn))
+			Expect(resource).To(MatchJQ(".attributes.organization_id", "org-123"))
+			Expect(resource).To(MatchJQ(".attributes.role_arn", ocmRoleArn))
+		})
+
+		It("Adds the role to the roles already linked", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, orgLabelsRoute+"/sts_ocm_role"),
+					RespondWithJSON(http.StatusOK, `{
+					  "key": "sts_ocm_role",
+					  "value": "`+otherRoleArn+`"
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, orgLabelsRoute+"/sts_ocm_role"),
+					VerifyJQ(".value", otherRoleArn+","+ocmRoleArn),
+					RespondWithJSON(http.StatusOK, `{
+					  "key": "sts_ocm_role",
+					  "value": "`+otherRoleArn+","+ocmRoleArn+`"
+					}`),
+				),
+			)
+			Terraform.Source(`
+			  resource "rhcs_ocm_role_link" "link" {
+			    organization_id = "org-123"
+			    role_arn        = "` + ocmRoleArn + `"
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+
+		It("Links two roles in the same apply", func() {
+			// The links are created in parallel and in any order, so the label is kept by the
+			// server instead of expecting a sequence of requests:
+			var lock sync.Mutex
+			value := ""
+			TestServer.RouteToHandler(http.MethodGet, orgLabelsRoute+"/sts_ocm_role",
+				func(w http.ResponseWriter, r *http.Request) {
+					lock.Lock()
+					current := value
+					lock.Unlock()
+					// Give the other link time to read the label as well if it isn't locked:
+					time.Sleep(500 * time.Millisecond)
+					if current == "" {
+						RespondWithJSON(http.StatusNotFound, "{}")(w, r)
+						return
+					}
+					RespondWithJSONEncoded(http.StatusOK, map[string]string{
+						"key":   "sts_ocm_role",
+						"value": current,
+					})(w, r)
+				},
+			)
+			saveLabel := func(w http.ResponseWriter, r *http.Request) {
+				label := map[string]string{}
+				Expect(json.NewDecoder(r.Body).Decode(&label)).To(Succeed())
+				lock.Lock()
+				value = label["value"]
+				lock.Unlock()
+				RespondWithJSONEncoded(http.StatusOK, label)(w, r)
+			}
+			TestServer.RouteToHandler(http.MethodPost, orgLabelsRoute, saveLabel)
+			TestServer.RouteToHandler(http.MethodPatch, orgLabelsRoute+"/sts_ocm_role", saveLabel)
+
+			Terraform.Source(`
+			  resource "rhcs_ocm_role_link" "link" {
+			    role_arn = "` + ocmRoleArn + `"
+			  }
+
+			  resource "rhcs_ocm_role_link" "other" {
+			    role_arn = "` + otherRoleArn + `"
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			Expect(strings.Split(value, ",")).To(ConsistOf(ocmRoleArn, otherRoleArn))
+		})
+
+		It("Fails if another role of the same AWS account is linked", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, orgLabelsRoute+"/sts_ocm_role"),
+					RespondWithJSON(http.StatusOK, `{
+					  "key": "sts_ocm_role",
+					  "value": "arn:aws:iam::123456789012:role/Other-OCM-Role"
+					}`),
+				),
+			)
+			Terraform.Source(`
+			  resource "rhcs_ocm_role_link" "link" {
+			    role_arn = "` + ocmRoleArn + `"
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("only one role can be linked per AWS account")
+		})
+
+		It("Fails at plan if the organization isn't the one of the current account", func() {
+			Terraform.Source(`
+			  resource "rhcs_ocm_role_link" "link" {
+			    organization_id = "org-456"
+			    role_arn        = "` + ocmRoleArn + `"
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("can only be linked to organization 'org-123'")
+		})
+
+		It("Fails if the ARN isn't the ARN of a role", func() {
+			Terraform.Source(`
+			  resource "rhcs_ocm_role_link" "link" {
+			    role_arn = "arn:aws:iam::123456789012:user/jdoe"
+			  }
+			`)
+			runOutput := Terraform.Validate()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("must be the ARN of an IAM role")
+		})
+
+		It("Unlinks the role and removes the empty label", func() {
+			linkOcmRole()
+
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, orgLabelsRoute+"/sts_ocm_role"),
+					RespondWithJSON(http.StatusOK, `{
+					  "key": "sts_ocm_role",
+					  "value": "`+ocmRoleArn+`"
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, orgLabelsRoute+"/sts_ocm_role"),
+					RespondWithJSON(http.StatusOK, `{
+					  "key": "sts_ocm_role",
+					  "value": "`+ocmRoleArn+`"
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, orgLabelsRoute+"/sts_ocm_role"),
+					RespondWithJSON(http.StatusNoContent, "{}"),
+				),
+			)
+			runOutput := Terraform.Destroy()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+
+		It("Removes the link from the state when the role is no longer linked", func() {
+			linkOcmRole()
+
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, orgLabelsRoute+"/sts_ocm_role"),
+					RespondWithJSON(http.StatusOK, `{
+					  "key": "sts_ocm_role",
+					  "value": "`+otherRoleArn+`"
+					}`),
+				),
+			)
+			runOutput := Terraform.Destroy()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+
+		It("Imports a link using only the role ARN", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, orgLabelsRoute+"/sts_ocm_role"),
+					RespondWithJSON(http.StatusOK, `{
+					  "key": "sts_ocm_role",
+					  "value": "`+otherRoleArn+","+ocmRoleArn+`"
+					}`),
+				),
+			)
+			Terraform.Source(`
+			  resource "rhcs_ocm_role_link" "link" {
+			    role_arn = "` + ocmRoleArn + `"
+			  }
+			`)
+			runOutput := Terraform.Import("rhcs_ocm_role_link.link", ocmRoleArn)
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			resource := Terraform.Resource("rhcs_ocm_role_link", "link")
+			Expect(resource).To(MatchJQ(".attributes.organization_id", "org-123"))
+			Expect(resource).To(MatchJQ(".attributes.role_arn", ocmRoleArn))
+		})
+	})
+
+	Context("User role link", func() {
+		It("Links the role to the current account", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, userLabelsRoute+"/sts_user_role"),
+					RespondWithJSON(http.StatusNotFound, "{}"),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, userLabelsRoute),
+					VerifyJQ(".key", "sts_user_role"),
+					VerifyJQ(".value", userRoleArn),
+					RespondWithJSON(http.StatusCreated, `{
+					  "key": "sts_user_role",
+					  "value": "`+userRoleArn+`"
+					}`),
+				),
+			)
+			Terraform.Source(`
+			  resource "rhcs_user_role_link" "link" {
+			    role_arn = "` + userRoleArn + `"
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			resource := Terraform.Resource("rhcs_user_role_link", "link")
+			Expect(resource).To(MatchJQ(".attributes.account_id", "acc-123"))
+			Expect(resource).To(MatchJQ(".attributes.role_arn", userRoleArn))
+		})
+
+		It("Fails at plan if the account isn't the current account", func() {
+			Terraform.Source(`
+			  resource "rhcs_user_role_link" "link" {
+			    account_id = "acc-456"
+			    role_arn   = "` + userRoleArn + `"
+			  }
+			`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("can only be linked to the current account 'acc-123'")
+		})
+
+		It("Imports a link and unlinks the role keeping the other roles", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, userLabelsRoute+"/sts_user_role"),
+					RespondWithJSON(http.StatusOK, `{
+					  "key": "sts_user_role",
+					  "value": "`+otherRoleArn+","+userRoleArn+`"
+					}`),
+				),
+			)
+			Terraform.Source(`
+			  resource "rhcs_user_role_link" "link" {
+			    role_arn = "` + userRoleArn + `"
+			  }
+			`)
+			runOutput := Terraform.Import("rhcs_user_role_link.link", "acc-123,"+userRoleArn)
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, userLabelsRoute+"/sts_user_role"),
+					RespondWithJSON(http.StatusOK, `{
+					  "key": "sts_user_role",
+					  "value": "`+otherRoleArn+","+userRoleArn+`"
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, userLabelsRoute+"/sts_user_role"),
+					RespondWithJSON(http.StatusOK, `{
+					  "key": "sts_user_role",
+					  "value": "`+otherRoleArn+","+userRoleArn+`"
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, userLabelsRoute+"/sts_user_role"),
+					VerifyJQ(".value", otherRoleArn),
+					RespondWithJSON(http.StatusOK, `{
+					  "key": "sts_user_role",
+					  "value": "`+otherRoleArn+`"
+					}`),
+				),
+			)
+			runOutput = Terraform.Destroy()
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("ROSA account roles data source", func() {
+	const stsPolicies = `{
+	  "kind": "STSPoliciesList",
+	  "page": 1,
+	  "size": 8,
+	  "total": 8,
+	  "items": [
+	    {
+	      "kind": "STSPolicy",
+	      "id": "sts_installer_permission_policy",
+	      "details": "{\"Statement\": [{\"Resource\": \"arn:aws:iam::*:role/*\"}]}",
+	      "type": "AccountRole"
+	    },
+	    {
+	      "kind": "STSPolicy",
+	      "id": "sts_support_permission_policy",
+	      "details": "{}",
+	      "type": "AccountRole"
+	    },
+	    {
+	      "kind": "STSPolicy",
+	      "id": "sts_instance_worker_permission_policy",
+	      "details": "{}",
+	      "type": "AccountRole"
+	    },
+	    {
+	      "kind": "STSPolicy",
+	      "id": "sts_instance_controlplane_permission_policy",
+	      "details": "{}",
+	      "type": "AccountRole"
+	    },
+	    {
+	      "kind": "STSPolicy",
+	      "id": "sts_installer_trust_policy",
+	      "details": "{\"Statement\": [{\"Principal\": {\"AWS\": [\"arn:aws:iam::710019948333:role/RH-Managed-OpenShift-Installer\"]}}]}",
+	      "type": "AccountRole"
+	    },
+	    {
+	      "kind": "STSPolicy",
+	      "id": "sts_support_trust_policy",
+	      "details": "{\"Version\": \"2012-10-17\", \"Statement\": [{\"Action\": [\"sts:AssumeRole\"], \"Effect\": \"Allow\", \"Principal\": {\"AWS\": [\"arn:aws:iam::12345678912:role/RH-Technical-Support-12345678\"]}}]}",
+	      "type": "AccountRole"
+	    },
+	    {
+	      "kind": "STSPolicy",
+	      "id": "sts_instance_worker_trust_policy",
+	      "details": "{\"Statement\": [{\"Principal\": {\"Service\": [\"ec2.amazonaws.com\"]}}]}",
+	      "type": "AccountRole"
+	    },
+	    {
+	      "kind": "STSPolicy",
+	      "id": "sts_instance_controlplane_trust_policy",
+	      "details": "{\"Statement\": [{\"Principal\": {\"Service\": [\"ec2.amazonaws.com\"]}}]}",
+	      "type": "AccountRole"
+	    }
+	  ]
+	}`
+
+	BeforeEach(func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/aws_inquiries/sts_policies"),
+				RespondWithJSON(http.StatusOK, stsPolicies),
+			),
+		)
+	})
+
+	It("Lists the account roles with the default prefix", func() {
+		Terraform.Source(`
+		  data "rhcs_rosa_account_roles" "account_roles" {
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_rosa_account_roles", "account_roles")
+		Expect(resource).To(MatchJQ(`.attributes.rh_support_role_arn`, "arn:aws:iam::12345678912:role/RH-Technical-Support-12345678"))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles | length`, 4))
+		Expect(resource).To(MatchJQ(`[.attributes.account_roles[].role_name]`, []interface{}{
+			"ManagedOpenShift-Installer-Role",
+			"ManagedOpenShift-Support-Role",
+			"ManagedOpenShift-Worker-Role",
+			"ManagedOpenShift-ControlPlane-Role",
+		}))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].policy_name`, "ManagedOpenShift-Installer-Role-Policy"))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].managed_policy`, false))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].policy_arn`, nil))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].role_arn`, nil))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].tags.rosa_role_type`, "installer"))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].tags.rosa_role_prefix`, "ManagedOpenShift"))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].tags | has("rosa_openshift_version")`, false))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[1].assume_role_policy | fromjson | .Statement[0].Principal.AWS[0]`,
+			"arn:aws:iam::12345678912:role/RH-Technical-Support-12345678"))
+	})
+
+	It("Computes the ARNs for an account, path, partition and version", func() {
+		Terraform.Source(`
+		  data "rhcs_rosa_account_roles" "account_roles" {
+		    account_role_prefix  = "my-prefix"
+		    account_id           = "123456789012"
+		    path                 = "/rosa/"
+		    aws_partition        = "aws-us-gov"
+		    openshift_version    = "4.15.3"
+		    permissions_boundary = "arn:aws-us-gov:iam::123456789012:policy/boundary"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_rosa_account_roles", "account_roles")
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].role_arn`, "arn:aws-us-gov:iam::123456789012:role/rosa/my-prefix-Installer-Role"))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].policy_arn`, "arn:aws-us-gov:iam::123456789012:policy/rosa/my-prefix-Installer-Role-Policy"))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].policy_document`, `{"Statement": [{"Resource": "arn:aws-us-gov:iam::*:role/*"}]}`))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].permissions_boundary`, "arn:aws-us-gov:iam::123456789012:policy/boundary"))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].tags.rosa_openshift_version`, "4.15"))
+	})
+})
@@ -222,6 +222,7 @@ var _ = Describe("OCM policies data source", func() {
 		resource := Terraform.Resource("rhcs_policies", "my_policies").(map[string]interface{})
 		Expect(fmt.Sprint(resource["attributes"])).To(Equal(fmt.Sprint(
 			map[string]interface{}{
+				"aws_partition": nil,
 				"operator_role_policies": map[string]interface{}{
 					"openshift_cloud_credential_operator_cloud_credential_operator_iam_ro_creds_policy": "{}",
 					"openshift_cloud_network_config_controller_cloud_credentials_policy":                "{}",
@@ -241,4 +242,52 @@ var _ = Describe("OCM policies data source", func() {
 			},
 		)))
 	})
+
+	It("Can list OCM policies for another AWS partition", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/aws_inquiries/sts_policies"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "STSPoliciesList",
+				  "page": 1,
+				  "size": 1,
+				  "total": 1,
+				  "items": [
+				    {
+				      "kind": "STSPolicy",
+				      "id": "sts_installer_permission_policy",
+				      "details": "{\"Resource\": [\"arn:aws:iam::*:role/*\", \"arn:%{partition}:s3:::*\"]}",
+				      "type": "AccountRole"
+				    }
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_policies" "my_policies" {
+		    aws_partition = "aws-us-gov"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_policies", "my_policies")
+		Expect(resource).To(MatchJQ(`.attributes.account_role_policies.sts_installer_permission_policy`,
+			`{"Resource": ["arn:aws-us-gov:iam::*:role/*", "arn:aws-us-gov:s3:::*"]}`))
+	})
+
+	It("Rejects unknown AWS partitions", func() {
+		Terraform.Source(`
+		  data "rhcs_policies" "my_policies" {
+		    aws_partition = "aws-moon"
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("aws_partition")
+	})
 })
@@ -17,8 +17,16 @@ limitations under the License.
 package classic
 
 import (
-	. "github.com/onsi/ginkgo/v2/dsl/core" // nolint
-	. "github.com/onsi/gomega"             // nolint
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
 	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
 )
 
@@ -40,4 +48,254 @@ var _ = Describe("Cluster creation", func() {
 		Expect(runOutput.ExitCode).To(BeZero())
 		Expect(Terraform.Destroy().ExitCode).To(BeZero())
 	})
+
+	Context("With a given private key and bucket name", func() {
+		var privateKey *rsa.PrivateKey
+
+		BeforeEach(func() {
+			var err error
+			privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		pkcs1PEM := func() string {
+			return string(pem.EncodeToMemory(&pem.Block{
+				Type:  "RSA PRIVATE KEY",
+				Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+			}))
+		}
+
+		source := func(attributes string) {
+			Terraform.Source(fmt.Sprintf(`
+				resource "rhcs_rosa_oidc_config_input" "oidc_input" {
+					region = "us-east-1"
+					%s
+				}
+			`, attributes))
+		}
+
+		It("Derives the same discovery document and JWKS when recreated", func() {
+			source(fmt.Sprintf("bucket_name = \"my-oidc-bucket\"\nprivate_key = %q", pkcs1PEM()))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			resource := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(resource).To(MatchJQ(".attributes.bucket_name", "my-oidc-bucket"))
+			Expect(resource).To(MatchJQ(".attributes.issuer_url", "https://my-oidc-bucket.s3.us-east-1.amazonaws.com"))
+			Expect(resource).To(MatchJQ(".attributes.private_key_secret_name", "rosa-private-key-my-oidc-bucket"))
+			Expect(resource).To(MatchJQ(".attributes.private_key_file_name", "rosa-private-key-my-oidc-bucket.key"))
+			Expect(resource).To(MatchJQ(".attributes.key_size", 2048.0))
+			Expect(resource).To(MatchJQ(".attributes.discovery_doc | fromjson | .jwks_uri",
+				"https://my-oidc-bucket.s3.us-east-1.amazonaws.com/keys.json"))
+			Expect(resource).To(MatchJQ(".attributes.jwks | fromjson | .keys[0].alg", "RS256"))
+			created := resource
+
+			Expect(Terraform.Destroy().ExitCode).To(BeZero())
+			runOutput = Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			resource = Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(resource).To(MatchJQ(".attributes.jwks", attributeOf(created, "jwks")))
+			Expect(resource).To(MatchJQ(".attributes.discovery_doc", attributeOf(created, "discovery_doc")))
+		})
+
+		It("Accepts a private key in PKCS #8 format", func() {
+			pkcs8, err := x509.MarshalPKCS8PrivateKey(privateKey)
+			Expect(err).ToNot(HaveOccurred())
+			source(fmt.Sprintf("private_key = %q", string(pem.EncodeToMemory(&pem.Block{
+				Type:  "PRIVATE KEY",
+				Bytes: pkcs8,
+			}))))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			resource := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(resource).To(MatchJQ(".attributes.key_size", 2048.0))
+			Expect(resource).To(MatchJQ(".attributes.bucket_name | startswith(\"oidc-\")", true))
+		})
+
+		It("Fails if the key size doesn't match the private key", func() {
+			source(fmt.Sprintf("key_size = 4096\nprivate_key = %q", pkcs1PEM()))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("the size of the private key is 2048 bits")
+		})
+
+		It("Fails if the private key isn't in PEM format", func() {
+			source(`private_key = "not a key"`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("it must be in PEM format")
+		})
+
+		It("Imports the resource without rotating the keys", func() {
+			source(fmt.Sprintf("bucket_name = \"my-oidc-bucket\"\nprivate_key = %q", pkcs1PEM()))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			created := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(Terraform.Destroy().ExitCode).To(BeZero())
+
+			runOutput = Terraform.Import("rhcs_rosa_oidc_config_input.oidc_input", "us-east-1,my-oidc-bucket")
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(resource).To(MatchJQ(".attributes.discovery_doc", attributeOf(created, "discovery_doc")))
+			Expect(resource).To(MatchJQ(".attributes.private_key", nil))
+
+			runOutput = Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource = Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(resource).To(MatchJQ(".attributes.jwks", attributeOf(created, "jwks")))
+			Expect(resource).To(MatchJQ(".attributes.key_size", 2048.0))
+		})
+	})
+
+	It("Generates a private key of the given size", func() {
+		Terraform.Source(`
+			resource "rhcs_rosa_oidc_config_input" "oidc_input" {
+				region   = "us-east-1"
+				key_size = 2048
+			}
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// The modulus of a 2048 bits key is 256 bytes, 342 characters in base64:
+		resource := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+		Expect(resource).To(MatchJQ(".attributes.jwks | fromjson | .keys[0].n | length", 342))
+	})
+
+	Context("Key rotation", func() {
+		source := func(attributes string) {
+			Terraform.Source(fmt.Sprintf(`
+				resource "rhcs_rosa_oidc_config_input" "oidc_input" {
+					region   = "us-east-1"
+					key_size = 2048
+					%s
+				}
+			`, attributes))
+		}
+
+		It("Rotates a generated private key", func() {
+			source("")
+			Expect(Terraform.Apply().ExitCode).To(BeZero())
+			created := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(created).To(MatchJQ(".attributes.signing_private_key", attributeOf(created, "private_key")))
+			Expect(created).To(MatchJQ(".attributes.next_private_key", nil))
+
+			By("Publishing the next key")
+			source(`rotation_phase = "publish"`)
+			Expect(Terraform.Apply().ExitCode).To(BeZero())
+			published := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(published).To(MatchJQ(".attributes.bucket_name", attributeOf(created, "bucket_name")))
+			Expect(published).To(MatchJQ(".attributes.private_key", attributeOf(created, "private_key")))
+			Expect(published).To(MatchJQ(".attributes.signing_private_key", attributeOf(created, "private_key")))
+			Expect(published).To(MatchJQ(".attributes.next_private_key != null", true))
+			Expect(published).To(MatchJQ(".attributes.jwks | fromjson | .keys | length", 2))
+			Expect(published).To(MatchJQ(".attributes.jwks | fromjson | .keys[0]",
+				firstKeyOf(created)))
+
+			By("Signing with the next key")
+			source(`rotation_phase = "activate"`)
+			Expect(Terraform.Apply().ExitCode).To(BeZero())
+			activated := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(activated).To(MatchJQ(".attributes.next_private_key", attributeOf(published, "next_private_key")))
+			Expect(activated).To(MatchJQ(".attributes.signing_private_key", attributeOf(published, "next_private_key")))
+			Expect(activated).To(MatchJQ(".attributes.jwks | fromjson | .keys | length", 2))
+			Expect(activated).To(MatchJQ(".attributes.jwks | fromjson | .keys[1]", firstKeyOf(created)))
+
+			By("Retiring the current key")
+			source(`rotation_phase = "retire"`)
+			Expect(Terraform.Apply().ExitCode).To(BeZero())
+			retired := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(retired).To(MatchJQ(".attributes.jwks | fromjson | .keys | length", 1))
+			Expect(retired).To(MatchJQ(".attributes.jwks | fromjson | .keys[0]", firstKeyOf(activated)))
+
+			By("Completing the rotation")
+			source("")
+			Expect(Terraform.Apply().ExitCode).To(BeZero())
+			completed := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(completed).To(MatchJQ(".attributes.bucket_name", attributeOf(created, "bucket_name")))
+			Expect(completed).To(MatchJQ(".attributes.private_key", attributeOf(published, "next_private_key")))
+			Expect(completed).To(MatchJQ(".attributes.signing_private_key", attributeOf(published, "next_private_key")))
+			Expect(completed).To(MatchJQ(".attributes.next_private_key", nil))
+			Expect(completed).To(MatchJQ(".attributes.rotation_phase", nil))
+			Expect(completed).To(MatchJQ(".attributes.jwks", attributeOf(retired, "jwks")))
+		})
+
+		It("Rotates to a given private key", func() {
+			currentKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).ToNot(HaveOccurred())
+			nextKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).ToNot(HaveOccurred())
+			current := string(pem.EncodeToMemory(&pem.Block{
+				Type:  "RSA PRIVATE KEY",
+				Bytes: x509.MarshalPKCS1PrivateKey(currentKey),
+			}))
+			next := string(pem.EncodeToMemory(&pem.Block{
+				Type:  "RSA PRIVATE KEY",
+				Bytes: x509.MarshalPKCS1PrivateKey(nextKey),
+			}))
+
+			source(fmt.Sprintf("private_key = %q", current))
+			Expect(Terraform.Apply().ExitCode).To(BeZero())
+			created := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+
+			for _, phase := range []string{"publish", "activate", "retire"} {
+				source(fmt.Sprintf("private_key = %q\nnext_private_key = %q\nrotation_phase = %q", current, next, phase))
+				Expect(Terraform.Apply().ExitCode).To(BeZero())
+			}
+			resource := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(resource).To(MatchJQ(".attributes.signing_private_key", next))
+
+			By("Failing to complete the rotation with another private key")
+			source(fmt.Sprintf("private_key = %q", current))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("the private key must be the value of 'next_private_key'")
+
+			By("Completing the rotation with the next private key")
+			source(fmt.Sprintf("private_key = %q", next))
+			Expect(Terraform.Apply().ExitCode).To(BeZero())
+			resource = Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(resource).To(MatchJQ(".attributes.bucket_name", attributeOf(created, "bucket_name")))
+			Expect(resource).To(MatchJQ(".attributes.private_key", next))
+			Expect(resource).To(MatchJQ(".attributes.jwks | fromjson | .keys | length", 1))
+		})
+
+		It("Fails if a rotation phase is skipped", func() {
+			source("")
+			Expect(Terraform.Apply().ExitCode).To(BeZero())
+
+			source(`rotation_phase = "activate"`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("can't change from no rotation to 'activate'")
+		})
+
+		It("Cancels a rotation before signing with the next key", func() {
+			source("")
+			Expect(Terraform.Apply().ExitCode).To(BeZero())
+			created := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+
+			source(`rotation_phase = "publish"`)
+			Expect(Terraform.Apply().ExitCode).To(BeZero())
+			source("")
+			Expect(Terraform.Apply().ExitCode).To(BeZero())
+
+			resource := Terraform.Resource("rhcs_rosa_oidc_config_input", "oidc_input")
+			Expect(resource).To(MatchJQ(".attributes.private_key", attributeOf(created, "private_key")))
+			Expect(resource).To(MatchJQ(".attributes.next_private_key", nil))
+			Expect(resource).To(MatchJQ(".attributes.jwks", attributeOf(created, "jwks")))
+		})
+	})
 })
+
+func attributeOf(resource interface{}, name string) interface{} {
+	return resource.(map[string]interface{})["attributes"].(map[string]interface{})[name]
+}
+
+func firstKeyOf(resource interface{}) interface{} {
+	jwks := map[string]interface{}{}
+	ExpectWithOffset(1, json.Unmarshal([]byte(attributeOf(resource, "jwks").(string)), &jwks)).To(Succeed())
+	return jwks["keys"].([]interface{})[0]
+}
@@ -156,6 +156,106 @@ var _ = Describe("ROSA Operator IAM roles data source", func() {
 			[]string{"system:serviceaccount:openshift-cloud-network-config-controller:cloud-network-config-controller"},
 		)
 	})
+	It("Can compute the policy ARNs for an AWS partition", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/aws_inquiries/sts_credential_requests"),
+				RespondWithJSON(http.StatusOK, getStsCredentialRequests),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_rosa_operator_roles" "operator_roles" {
+			  operator_role_prefix = "terraform-operator"
+			  account_role_prefix = "TerraformAccountPrefix"
+			  account_id = "123456789012"
+			  aws_partition = "aws-us-gov"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_rosa_operator_roles", "operator_roles")
+		Expect(resource).To(MatchJQ(`.attributes.operator_iam_roles[] | select(.operator_name == "ebs-cloud-credentials") | .policy_arn`,
+			"arn:aws-us-gov:iam::123456789012:policy/TerraformAccountPrefix-openshift-cluster-csi-drivers-ebs-cloud-c"))
+	})
+
+	It("Doesn't compute the policy ARNs without an account ID", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/aws_inquiries/sts_credential_requests"),
+				RespondWithJSON(http.StatusOK, getStsCredentialRequests),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_rosa_operator_roles" "operator_roles" {
+			  operator_role_prefix = "terraform-operator"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_rosa_operator_roles", "operator_roles")
+		Expect(resource).To(MatchJQ(`[.attributes.operator_iam_roles[].policy_arn | select(. != null)] | length`, 0))
+	})
+	It("Can compute the trust policies of the roles", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/aws_inquiries/sts_credential_requests"),
+				RespondWithJSON(http.StatusOK, getStsCredentialRequests),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_rosa_operator_roles" "operator_roles" {
+			  operator_role_prefix = "terraform-operator"
+			  account_role_prefix = "TerraformAccountPrefix"
+			  account_id = "123456789012"
+			  path = "/rosa/"
+			  oidc_endpoint_url = "https://oidc.example.com/abc"
+			  permissions_boundary = "arn:aws:iam::123456789012:policy/boundary"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_rosa_operator_roles", "operator_roles")
+		const role = `.attributes.operator_iam_roles[] | select(.operator_name == "ebs-cloud-credentials")`
+		Expect(resource).To(MatchJQ(role+" | .role_arn",
+			"arn:aws:iam::123456789012:role/rosa/terraform-operator-openshift-cluster-csi-drivers-ebs-cloud-crede"))
+		Expect(resource).To(MatchJQ(role+" | .policy_arn",
+			"arn:aws:iam::123456789012:policy/rosa/TerraformAccountPrefix-openshift-cluster-csi-drivers-ebs-cloud-c"))
+		Expect(resource).To(MatchJQ(role+" | .permissions_boundary", "arn:aws:iam::123456789012:policy/boundary"))
+		Expect(resource).To(MatchJQ(role+" | .assume_role_policy | fromjson | .Statement[0].Principal.Federated",
+			"arn:aws:iam::123456789012:oidc-provider/oidc.example.com/abc"))
+		Expect(resource).To(MatchJQ(role+` | .assume_role_policy | fromjson | .Statement[0].Condition.StringEquals["oidc.example.com/abc:sub"]`,
+			[]interface{}{
+				"system:serviceaccount:openshift-cluster-csi-drivers:aws-ebs-csi-driver-operator",
+				"system:serviceaccount:openshift-cluster-csi-drivers:aws-ebs-csi-driver-controller-sa",
+			}))
+	})
+
+	It("Rejects paths that don't start and end with a slash", func() {
+		Terraform.Source(`
+		  data "rhcs_rosa_operator_roles" "operator_roles" {
+			  operator_role_prefix = "terraform-operator"
+			  path = "rosa"
+		  }
+		`)
+		runOutput := Terraform.Validate()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("path must start and end with '/'")
+	})
 })
 
 func compareResultOfRoles(resource interface{}, index int, name, namespace, policyName, roleName string, serviceAccountLen int, serviceAccounts []string) {
@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Version gate agreement resource", func() {
+	const agreementsRoute = "/api/clusters_mgmt/v1/clusters/123/gate_agreements"
+	const agreement = `{
+	  "kind": "VersionGateAgreement",
+	  "id": "888",
+	  "version_gate": {
+	    "kind": "VersionGate",
+	    "id": "998",
+	    "version_raw_id_prefix": "4.15"
+	  },
+	  "agreed_timestamp": "2024-06-01T02:00:00Z"
+	}`
+
+	createAgreement := func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, agreementsRoute),
+				VerifyJQ(".version_gate.id", "998"),
+				RespondWithJSON(http.StatusCreated, agreement),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_version_gate_agreement" "agreement" {
+		    cluster         = "123"
+		    version_gate_id = "998"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+	}
+
+	It("Agrees to a version gate", func() {
+		createAgreement()
+
+		resource := Terraform.Resource("rhcs_version_gate_agreement", "agreement")
+		Expect(resource).To(MatchJQ(".attributes.id", "888"))
+		Expect(resource).To(MatchJQ(".attributes.cluster", "123"))
+		Expect(resource).To(MatchJQ(".attributes.version_gate_id", "998"))
+		Expect(resource).To(MatchJQ(".attributes.agreed_timestamp", "2024-06-01T02:00:00Z"))
+	})
+
+	It("Fails if the version gate can't be agreed", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, agreementsRoute),
+				RespondWithJSON(http.StatusBadRequest, `{
+				  "kind": "Error",
+				  "id": "400",
+				  "href": "/api/clusters_mgmt/v1/errors/400",
+				  "code": "CLUSTERS-MGMT-400",
+				  "reason": "Version gate '997' doesn't exist"
+				}`),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_version_gate_agreement" "agreement" {
+		    cluster         = "123"
+		    version_gate_id = "997"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Version gate '997' doesn't exist")
+	})
+
+	It("Removes the agreement from the state when it doesn't exist", func() {
+		createAgreement()
+
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, agreementsRoute+"/888"),
+				RespondWithJSON(http.StatusNotFound, "{}"),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, agreementsRoute),
+				RespondWithJSON(http.StatusCreated, agreement),
+			),
+		)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+	})
+
+	It("Deletes the agreement", func() {
+		createAgreement()
+
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, agreementsRoute+"/888"),
+				RespondWithJSON(http.StatusOK, agreement),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodDelete, agreementsRoute+"/888"),
+				RespondWithJSON(http.StatusNoContent, "{}"),
+			),
+		)
+		runOutput := Terraform.Destroy()
+		Expect(runOutput.ExitCode).To(BeZero())
+	})
+
+	It("Imports an agreement", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, agreementsRoute+"/888"),
+				RespondWithJSON(http.StatusOK, agreement),
+			),
+		)
+		Terraform.Source(`
+		  resource "rhcs_version_gate_agreement" "agreement" {
+		    cluster         = "123"
+		    version_gate_id = "998"
+		  }
+		`)
+		runOutput := Terraform.Import("rhcs_version_gate_agreement.agreement", "123,888")
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_version_gate_agreement", "agreement")
+		Expect(resource).To(MatchJQ(".attributes.version_gate_id", "998"))
+	})
+})
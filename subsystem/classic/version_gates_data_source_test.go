@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classic
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Version gates data source", func() {
+	It("Lists the gates of the crossed minor versions", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/version_gates"),
+				VerifyFormKV("search", "version_raw_id_prefix in ('4.14','4.15')"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 2,
+				  "total": 2,
+				  "items": [
+				    {
+				      "id": "999",
+				      "version_raw_id_prefix": "4.14",
+				      "label": "api.openshift.com/gate-sts",
+				      "value": "4.14",
+				      "description": "STS clusters include new required cloud provider permissions.",
+				      "documentation_url": "https://access.redhat.com/solutions/0000000",
+				      "sts_only": true
+				    },
+				    {
+				      "id": "998",
+				      "version_raw_id_prefix": "4.15",
+				      "label": "api.openshift.com/gate-ocp",
+				      "value": "4.15",
+				      "description": "Removed Kubernetes APIs.",
+				      "warning_message": "Workloads using the removed APIs will break.",
+				      "documentation_url": "https://access.redhat.com/articles/0000000",
+				      "sts_only": false
+				    }
+				  ]
+				}`),
+			),
+		)
+
+		Terraform.Source(`
+		  data "rhcs_version_gates" "gates" {
+		    from_version = "4.13.10"
+		    to_version   = "4.15.2"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_version_gates", "gates")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 2))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "999"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].sts_only`, true))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].warning_message`, nil))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].id`, "998"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version_raw_id_prefix`, "4.15"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].warning_message`,
+			"Workloads using the removed APIs will break."))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].documentation_url`,
+			"https://access.redhat.com/articles/0000000"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].sts_only`, false))
+	})
+
+	It("Returns no gates for z-stream upgrades", func() {
+		Terraform.Source(`
+		  data "rhcs_version_gates" "gates" {
+		    from_version = "4.15.1"
+		    to_version   = "4.15.2"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_version_gates", "gates")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 0))
+	})
+
+	It("Fails if the target version is older", func() {
+		Terraform.Source(`
+		  data "rhcs_version_gates" "gates" {
+		    from_version = "4.15.1"
+		    to_version   = "4.14.2"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("version '4.14.2' is older than version '4.15.1'")
+	})
+})
@@ -207,4 +207,164 @@ var _ = Describe("Versions data source", func() {
 		resource := Terraform.Resource("rhcs_versions", "my_versions")
 		Expect(resource).To(MatchJQ(`.attributes.item`, nil))
 	})
+
+	It("Populates the version metadata", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 1,
+				  "total": 1,
+				  "items": [
+				    {
+				      "id": "openshift-v4.15.2",
+				      "raw_id": "4.15.2",
+				      "channel_group": "stable",
+				      "rosa_enabled": true,
+				      "hosted_control_plane_enabled": true,
+				      "default": true,
+				      "end_of_life_timestamp": "2025-06-27T00:00:00Z",
+				      "available_upgrades": [
+				        "4.15.3",
+				        "4.16.0"
+				      ],
+				      "image_overrides": {
+				        "aws": [
+				          {
+				            "ami": "ami-0123456789",
+				            "product": {
+				              "id": "rosa"
+				            },
+				            "region": {
+				              "id": "us-east-1"
+				            }
+				          }
+				        ]
+				      }
+				    }
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_versions" "my_versions" {
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_versions", "my_versions")
+		Expect(resource).To(MatchJQ(`.attributes.item.channel_group`, "stable"))
+		Expect(resource).To(MatchJQ(`.attributes.item.rosa_enabled`, true))
+		Expect(resource).To(MatchJQ(`.attributes.item.hosted_control_plane_enabled`, true))
+		Expect(resource).To(MatchJQ(`.attributes.item.default`, true))
+		Expect(resource).To(MatchJQ(`.attributes.item.end_of_life_timestamp`, "2025-06-27T00:00:00Z"))
+		Expect(resource).To(MatchJQ(`.attributes.item.available_upgrades`, []interface{}{"4.15.3", "4.16.0"}))
+		Expect(resource).To(MatchJQ(`.attributes.item.ami_overrides | length`, 1))
+		Expect(resource).To(MatchJQ(`.attributes.item.ami_overrides[0].product`, "rosa"))
+		Expect(resource).To(MatchJQ(`.attributes.item.ami_overrides[0].region`, "us-east-1"))
+		Expect(resource).To(MatchJQ(`.attributes.item.ami_overrides[0].ami`, "ami-0123456789"))
+		Expect(resource).To(MatchJQ(`.attributes.latest.id`, "openshift-v4.15.2"))
+	})
+
+	It("Filters by topology, channel group and version constraint", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+				VerifyFormKV("search", "(enabled = 't') and channel_group = 'fast' and hosted_control_plane_enabled = 't'"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 4,
+				  "total": 4,
+				  "items": [
+				    {
+				      "id": "openshift-v4.14.20-fast",
+				      "raw_id": "4.14.20"
+				    },
+				    {
+				      "id": "openshift-v4.15.10-fast",
+				      "raw_id": "4.15.10"
+				    },
+				    {
+				      "id": "openshift-v4.15.9-fast",
+				      "raw_id": "4.15.9"
+				    },
+				    {
+				      "id": "openshift-v4.16.0-fast",
+				      "raw_id": "4.16.0"
+				    }
+				  ]
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_versions" "my_versions" {
+		    search             = "enabled = 't'"
+		    topology           = "hcp"
+		    channel_group      = "fast"
+		    version_constraint = "~> 4.15.0"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_versions", "my_versions")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 2))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].name`, "4.15.10"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].name`, "4.15.9"))
+		Expect(resource).To(MatchJQ(`.attributes.item`, nil))
+		Expect(resource).To(MatchJQ(`.attributes.latest.id`, "openshift-v4.15.10-fast"))
+		Expect(resource).To(MatchJQ(`.attributes.latest.name`, "4.15.10"))
+	})
+
+	It("Adds the topology to the default search", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+				VerifyFormKV("search", "(enabled = 't') and rosa_enabled = 't'"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 0,
+				  "total": 0,
+				  "items": []
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_versions" "my_versions" {
+		    topology = "classic"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_versions", "my_versions")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 0))
+		Expect(resource).To(MatchJQ(`.attributes.latest`, nil))
+	})
+
+	It("Fails if the version constraint is invalid", func() {
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_versions" "my_versions" {
+		    version_constraint = "not-a-constraint"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Invalid version constraint")
+	})
 })
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -40,6 +41,18 @@ var (
 	TestingT   *testing.T
 )
 
+// ClusterOidcThumbprint is the thumbprint returned for the OIDC issuers of the clusters when
+// RouteClusterOidcThumbprint is used.
+const ClusterOidcThumbprint = "9e99a48a9960b14926bb7f3b02e22da2b0ab7280"
+
+// RouteClusterOidcThumbprint makes the server answer all the requests to calculate the thumbprint of
+// an OIDC issuer with ClusterOidcThumbprint. The clusters request it every time their state is
+// populated, so tests of clusters use this instead of appending a handler for each of them.
+func RouteClusterOidcThumbprint() {
+	TestServer.RouteToHandler(http.MethodPost, "/api/clusters_mgmt/v1/aws_inquiries/oidc_thumbprint",
+		RespondWithJSON(http.StatusOK, fmt.Sprintf(`{"thumbprint": "%s"}`, ClusterOidcThumbprint)))
+}
+
 // TerraformRunnerBuilder contains the data and logic needed to build a terraform runner.
 type TerraformRunnerBuilder struct {
 	url   string
@@ -57,6 +70,10 @@ func (ro *RunOutput) VerifyErrorContainsSubstring(sub string) {
 	Expect(ro.err).To(ContainSubstring(sub))
 }
 
+func (ro *RunOutput) VerifyOutputContainsSubstring(sub string) {
+	Expect(ro.out).To(ContainSubstring(sub))
+}
+
 // TerraformRunner contains the data and logic needed to run Terraform.
 type TerraformRunner struct {
 	binary string
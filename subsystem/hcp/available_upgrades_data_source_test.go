@@ -0,0 +1,301 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcp
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Available upgrades data sources", func() {
+	const hcpCluster = `{
+	  "id": "123",
+	  "name": "my-cluster",
+	  "state": "ready",
+	  "hypershift": {
+	    "enabled": true
+	  },
+	  "version": {
+	    "id": "openshift-v4.14.0",
+	    "raw_id": "4.14.0",
+	    "channel_group": "stable",
+	    "available_upgrades": ["4.14.1", "4.15.0"]
+	  }
+	}`
+	const nodePool = `{
+	  "id": "pool1",
+	  "version": {
+	    "id": "openshift-v4.14.0",
+	    "raw_id": "4.14.0",
+	    "available_upgrades": ["4.14.1", "4.15.0"]
+	  }
+	}`
+	const missingGates = `{
+	  "kind": "Error",
+	  "id": "400",
+	  "href": "/api/clusters_mgmt/v1/errors/400",
+	  "code": "CLUSTERS-MGMT-400",
+	  "reason": "There are missing version gate agreements for this cluster. See details.",
+	  "details": [
+	    {
+	      "kind": "VersionGate",
+	      "id": "gate-sts",
+	      "description": "STS roles must be updated.",
+	      "documentation_url": "https://access.redhat.com/solutions/0000000",
+	      "sts_only": true
+	    },
+	    {
+	      "kind": "VersionGate",
+	      "id": "gate-api",
+	      "description": "Removed Kubernetes APIs.",
+	      "warning_message": "Workloads must be migrated.",
+	      "documentation_url": "https://access.redhat.com/solutions/1111111",
+	      "sts_only": false
+	    }
+	  ]
+	}`
+
+	It("Lists the available upgrades of a cluster", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123"),
+				RespondWithJSON(http.StatusOK, hcpCluster),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123"),
+				RespondWithJSON(http.StatusOK, hcpCluster),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+				VerifyFormKV("search", "id in ('openshift-v4.14.1', 'openshift-v4.15.0')"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 2,
+				  "total": 2,
+				  "items": [
+				    {
+				      "id": "openshift-v4.14.1",
+				      "raw_id": "4.14.1",
+				      "hosted_control_plane_enabled": true
+				    },
+				    {
+				      "id": "openshift-v4.15.0",
+				      "raw_id": "4.15.0",
+				      "hosted_control_plane_enabled": true
+				    }
+				  ]
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, clusterUri+"123/control_plane/upgrade_policies", "dryRun=true"),
+				VerifyJQ(".version", "4.14.1"),
+				RespondWithJSON(http.StatusNoContent, ""),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, clusterUri+"123/control_plane/upgrade_policies", "dryRun=true"),
+				VerifyJQ(".version", "4.15.0"),
+				RespondWithJSON(http.StatusBadRequest, missingGates),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_cluster_available_upgrades" "upgrades" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster_available_upgrades", "upgrades")
+		Expect(resource).To(MatchJQ(`.attributes.current_version`, "4.14.0"))
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 2))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "openshift-v4.14.1"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].name`, "4.14.1"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].requires_acknowledgement`, false))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].version_gates | length`, 0))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].name`, "4.15.0"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].requires_acknowledgement`, true))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version_gates | length`, 2))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version_gates[0].id`, "gate-sts"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version_gates[0].sts_only`, true))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version_gates[0].requires_acknowledgement`, false))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version_gates[0].warning_message`, nil))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version_gates[1].id`, "gate-api"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version_gates[1].description`, "Removed Kubernetes APIs."))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version_gates[1].warning_message`, "Workloads must be migrated."))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version_gates[1].documentation_url`, "https://access.redhat.com/solutions/1111111"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version_gates[1].requires_acknowledgement`, true))
+	})
+
+	It("Skips the versions that aren't enabled for hosted control plane", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123"),
+				RespondWithJSON(http.StatusOK, hcpCluster),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123"),
+				RespondWithJSON(http.StatusOK, hcpCluster),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+				VerifyFormKV("search", "id in ('openshift-v4.14.1', 'openshift-v4.15.0')"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 2,
+				  "total": 2,
+				  "items": [
+				    {
+				      "id": "openshift-v4.14.1",
+				      "raw_id": "4.14.1",
+				      "hosted_control_plane_enabled": true
+				    },
+				    {
+				      "id": "openshift-v4.15.0",
+				      "raw_id": "4.15.0",
+				      "hosted_control_plane_enabled": false
+				    }
+				  ]
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, clusterUri+"123/control_plane/upgrade_policies", "dryRun=true"),
+				VerifyJQ(".version", "4.14.1"),
+				RespondWithJSON(http.StatusNoContent, ""),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_cluster_available_upgrades" "upgrades" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_cluster_available_upgrades", "upgrades")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 1))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].name`, "4.14.1"))
+	})
+
+	It("Lists the available upgrades of a machine pool", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123/node_pools/pool1"),
+				RespondWithJSON(http.StatusOK, nodePool),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123"),
+				RespondWithJSON(http.StatusOK, hcpCluster),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123/node_pools/pool1"),
+				RespondWithJSON(http.StatusOK, nodePool),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+				VerifyFormKV("search", "id in ('openshift-v4.14.1', 'openshift-v4.15.0')"),
+				RespondWithJSON(http.StatusOK, `{
+				  "page": 1,
+				  "size": 2,
+				  "total": 2,
+				  "items": [
+				    {
+				      "id": "openshift-v4.14.1",
+				      "raw_id": "4.14.1",
+				      "hosted_control_plane_enabled": true
+				    },
+				    {
+				      "id": "openshift-v4.15.0",
+				      "raw_id": "4.15.0",
+				      "hosted_control_plane_enabled": true
+				    }
+				  ]
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, clusterUri+"123/node_pools/pool1/upgrade_policies", "dryRun=true"),
+				VerifyJQ(".version", "4.14.1"),
+				RespondWithJSON(http.StatusNoContent, ""),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, clusterUri+"123/node_pools/pool1/upgrade_policies", "dryRun=true"),
+				VerifyJQ(".version", "4.15.0"),
+				RespondWithJSON(http.StatusBadRequest, missingGates),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_hcp_machine_pool_available_upgrades" "upgrades" {
+		    cluster      = "123"
+		    machine_pool = "pool1"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_hcp_machine_pool_available_upgrades", "upgrades")
+		Expect(resource).To(MatchJQ(`.attributes.current_version`, "4.14.0"))
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 2))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].name`, "4.14.1"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].requires_acknowledgement`, false))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].name`, "4.15.0"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].requires_acknowledgement`, true))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].version_gates | length`, 2))
+	})
+
+	It("Fails if the machine pool doesn't exist", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123/node_pools/pool1"),
+				RespondWithJSON(http.StatusNotFound, `{
+				  "kind": "Error",
+				  "id": "404",
+				  "href": "/api/clusters_mgmt/v1/errors/404",
+				  "code": "CLUSTERS-MGMT-404",
+				  "reason": "Node pool with id 'pool1' not found."
+				}`),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_hcp_machine_pool_available_upgrades" "upgrades" {
+		    cluster      = "123"
+		    machine_pool = "pool1"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Can't find machine pool")
+	})
+})
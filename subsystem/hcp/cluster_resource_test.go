@@ -38,6 +38,10 @@ const (
 )
 
 var _ = Describe("HCP Cluster", func() {
+	BeforeEach(func() {
+		RouteClusterOidcThumbprint()
+	})
+
 	// cmv1 doesn't have a marshaling option for page
 	versionListPage := `
 	{
@@ -78,6 +82,7 @@ var _ = Describe("HCP Cluster", func() {
 	err = cmv1.MarshalVersion(v4141Spec, b)
 	Expect(err).ToNot(HaveOccurred())
 	v4141Info := b.String()
+	v4141List := fmt.Sprintf(`{"page": 1, "size": 1, "total": 1, "items": [%s]}`, v4141Info)
 	const emptyControlPlaneUpgradePolicies = `
 	{
 		"page": 1,
@@ -889,6 +894,7 @@ var _ = Describe("HCP Cluster", func() {
 			Expect(runOutput.ExitCode).To(BeZero())
 			resource := Terraform.Resource("rhcs_cluster_rosa_hcp", "my_cluster")
 			Expect(resource).To(MatchJQ(".attributes.current_version", "4.14.0"))
+			Expect(resource).To(MatchJQ(".attributes.sts.thumbprint", ClusterOidcThumbprint))
 		})
 
 		It("Creates basic cluster without set http tokens", func() {
@@ -3388,6 +3394,138 @@ var _ = Describe("HCP Cluster", func() {
 				"PEM"))
 		})
 
+		It("Refuses to destroy or replace a cluster with delete protection until it is disabled", func() {
+			protectedCluster := func(enabled bool) http.HandlerFunc {
+				return RespondWithPatchedJSON(http.StatusOK, template, fmt.Sprintf(`[
+				{
+				  "op": "add",
+				  "path": "/aws",
+				  "value": {
+					  "sts" : {
+						  "oidc_endpoint_url": "https://127.0.0.1",
+						  "thumbprint": "111111",
+						  "role_arn": "",
+						  "support_role_arn": "",
+						  "instance_iam_roles" : {
+							"worker_role_arn" : ""
+						  },
+						  "operator_role_prefix" : "test"
+					  }
+				  }
+				},
+				{
+				  "op": "add",
+				  "path": "/delete_protection",
+				  "value": {
+					  "enabled": %t
+				  }
+				}]`, enabled))
+			}
+			clusterSource := func(deleteProtection bool) string {
+				return fmt.Sprintf(`
+				resource "rhcs_cluster_rosa_hcp" "my_cluster" {
+					name           = "my-cluster"
+					cloud_region   = "us-west-1"
+					aws_account_id = "123456789012"
+					aws_billing_account_id = "123456789012"
+					disable_waiting_in_destroy = true
+					delete_protection = %t
+					sts = {
+						operator_role_prefix = "test"
+						role_arn = "",
+						support_role_arn = "",
+						instance_iam_roles = {
+							worker_role_arn = "",
+						}
+					}
+					aws_subnet_ids = [
+						"id1", "id2", "id3"
+					]
+					availability_zones = [
+						"us-west-1a",
+						"us-west-1b",
+						"us-west-1c",
+					]
+				}`, deleteProtection)
+			}
+
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					RespondWithJSON(http.StatusOK, versionListPage),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/api/clusters_mgmt/v1/clusters"),
+					VerifyJQ(`.delete_protection.enabled`, true),
+					protectedCluster(true),
+				),
+			)
+			Terraform.Source(clusterSource(true))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_cluster_rosa_hcp", "my_cluster")
+			Expect(resource).To(MatchJQ(`.attributes.delete_protection`, true))
+
+			// The destroy fails while planning
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route),
+					protectedCluster(true),
+				),
+			)
+			runOutput = Terraform.Destroy()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Cluster '123' has delete protection enabled and can't be destroyed")
+
+			// The replacement fails before the cluster is deleted or a new one is created
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route),
+					protectedCluster(true),
+				),
+			)
+			runOutput = Terraform.Run("apply", "-auto-approve", "-replace=rhcs_cluster_rosa_hcp.my_cluster")
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Cluster '123' has delete protection enabled and can't be destroyed or replaced")
+			resource = Terraform.Resource("rhcs_cluster_rosa_hcp", "my_cluster")
+			Expect(resource).To(MatchJQ(`.attributes.id`, "123"))
+
+			// Disable the delete protection
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route),
+					protectedCluster(true),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, cluster123Route+"/delete_protection"),
+					VerifyJQ(`.enabled`, false),
+					RespondWithJSON(http.StatusOK, `{"enabled": false}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, cluster123Route),
+					protectedCluster(true),
+				),
+			)
+			Terraform.Source(clusterSource(false))
+			runOutput = Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource = Terraform.Resource("rhcs_cluster_rosa_hcp", "my_cluster")
+			Expect(resource).To(MatchJQ(`.attributes.delete_protection`, false))
+
+			// And now the cluster can be destroyed
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route),
+					protectedCluster(false),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, cluster123Route),
+					RespondWithJSON(http.StatusOK, template),
+				),
+			)
+			Expect(Terraform.Destroy().ExitCode).To(BeZero())
+		})
+
 		Context("Test destroy cluster", func() {
 			BeforeEach(func() {
 				TestServer.AppendHandlers(
@@ -4527,6 +4665,16 @@ var _ = Describe("HCP Cluster", func() {
 						}
 					]`),
 				),
+				// Check for conflicting upgrade policies while planning
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, emptyControlPlaneUpgradePolicies),
+				),
+				// And again when applying the plan
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, emptyControlPlaneUpgradePolicies),
+				),
 				CombineHandlers(
 					VerifyRequest(http.MethodGet, cluster123Route),
 					RespondWithPatchedJSON(http.StatusOK, template, `
@@ -4558,8 +4706,9 @@ var _ = Describe("HCP Cluster", func() {
 					]`),
 				),
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.1"),
-					RespondWithJSON(http.StatusOK, v4141Info),
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+					RespondWithJSON(http.StatusOK, v4141List),
 				),
 				// Look for existing upgrade policies
 				CombineHandlers(
@@ -4711,6 +4860,68 @@ var _ = Describe("HCP Cluster", func() {
 						}
 					]`),
 				),
+				// Check for conflicting upgrade policies while planning
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"id": "456",
+								"schedule_type": "manual",
+								"upgrade_type": "ControlPlane",
+								"version": "4.14.0",
+								"next_run": "2023-06-09T20:59:00Z",
+								"cluster_id": "123",
+								"enable_minor_version_upgrades": true
+							}
+						]
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, `
+					{
+						"id": "456",
+						"state": {
+							"description": "Upgrade in progress",
+							"value": "started"
+						}
+					}`),
+				),
+				// And again when applying the plan
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"id": "456",
+								"schedule_type": "manual",
+								"upgrade_type": "ControlPlane",
+								"version": "4.14.0",
+								"next_run": "2023-06-09T20:59:00Z",
+								"cluster_id": "123",
+								"enable_minor_version_upgrades": true
+							}
+						]
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, `
+					{
+						"id": "456",
+						"state": {
+							"description": "Upgrade in progress",
+							"value": "started"
+						}
+					}`),
+				),
 				CombineHandlers(
 					VerifyRequest(http.MethodGet, cluster123Route),
 					RespondWithPatchedJSON(http.StatusOK, template, `
@@ -4742,8 +4953,9 @@ var _ = Describe("HCP Cluster", func() {
 					]`),
 				),
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.1"),
-					RespondWithJSON(http.StatusOK, v4141Info),
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+					RespondWithJSON(http.StatusOK, v4141List),
 				),
 				// Look for existing upgrade policies
 				CombineHandlers(
@@ -4807,6 +5019,358 @@ var _ = Describe("HCP Cluster", func() {
 			Expect(Terraform.Apply()).NotTo(BeZero())
 		})
 
+		It("Waits for an upgrade in progress to the desired version", func() {
+			const startedPolicies = `{
+				"page": 1,
+				"size": 1,
+				"total": 1,
+				"items": [
+					{
+						"id": "456",
+						"schedule_type": "manual",
+						"upgrade_type": "ControlPlane",
+						"version": "4.14.1",
+						"next_run": "2023-06-09T20:59:00Z",
+						"cluster_id": "123"
+					}
+				]
+			}`
+			const startedPolicy = `{
+				"id": "456",
+				"state": {
+					"description": "Upgrade in progress",
+					"value": "started"
+				}
+			}`
+			clusterWithSts := `
+			[
+				{
+					"op": "add",
+					"path": "/aws",
+					"value": {
+						"sts" : {
+							"oidc_endpoint_url": "https://127.0.0.1",
+							"thumbprint": "111111",
+							"role_arn": "",
+							"support_role_arn": "",
+							"instance_iam_roles" : {
+								"worker_role_arn" : ""
+							},
+							"operator_role_prefix" : "test"
+						}
+					}
+				},
+				{
+					"op": "add",
+					"path": "/properties",
+					"value": {
+						"rosa_tf_commit": "",
+						"rosa_tf_version": ""
+					}
+				}
+			]`
+			TestServer.AppendHandlers(
+				// Refresh cluster state
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route),
+					RespondWithPatchedJSON(http.StatusOK, template, clusterWithSts),
+				),
+			)
+			// Check for conflicting upgrade policies while planning and applying
+			for i := 0; i < 2; i++ {
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, startedPolicies),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies/456"),
+						RespondWithJSON(http.StatusOK, startedPolicy),
+					),
+				)
+			}
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route),
+					RespondWithPatchedJSON(http.StatusOK, template, clusterWithSts),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+					RespondWithJSON(http.StatusOK, v4141List),
+				),
+				// Look for existing upgrade policies
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, startedPolicies),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, startedPolicy),
+				),
+				// Wait for the upgrade in progress
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, `
+					{
+						"id": "456",
+						"state": {
+							"description": "Upgrade completed",
+							"value": "completed"
+						}
+					}`),
+				),
+				// Patch the cluster (w/ no changes)
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, cluster123Route),
+					RespondWithPatchedJSON(http.StatusCreated, template, clusterWithSts),
+				),
+			)
+			Terraform.Source(`
+			resource "rhcs_cluster_rosa_hcp" "my_cluster" {
+				name           = "my-cluster"
+				cloud_region   = "us-west-1"
+				aws_account_id = "123456789012"
+				aws_billing_account_id = "123456789012"
+				sts = {
+					operator_role_prefix = "test"
+					role_arn = ""
+					support_role_arn = ""
+					instance_iam_roles = {
+						worker_role_arn = ""
+					}
+				}
+				aws_subnet_ids = [
+					"id1", "id2", "id3"
+				]
+				availability_zones = [
+					"us-west-1a",
+					"us-west-1b",
+					"us-west-1c",
+				]
+				version = "4.14.1"
+				wait_for_upgrade_complete = true
+				max_upgrade_wait_timeout_in_minutes = 30
+			}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_cluster_rosa_hcp", "my_cluster")
+			Expect(resource).To(MatchJQ(".attributes.max_upgrade_wait_timeout_in_minutes", 30.0))
+		})
+
+		Context("Upgrade preflight checks", func() {
+			clusterPatch := `
+			[
+				{
+					"op": "add",
+					"path": "/aws",
+					"value": {
+						"sts" : {
+							"oidc_endpoint_url": "https://127.0.0.1",
+							"thumbprint": "111111",
+							"role_arn": "",
+							"support_role_arn": "",
+							"instance_iam_roles" : {
+								"worker_role_arn" : ""
+							},
+							"operator_role_prefix" : "test"
+						}
+					}
+				},
+				{
+					"op": "add",
+					"path": "/properties",
+					"value": {
+						"rosa_tf_commit": "",
+						"rosa_tf_version": ""
+					}
+				}
+			]`
+			clusterSource := func(force bool) string {
+				return fmt.Sprintf(`
+				resource "rhcs_cluster_rosa_hcp" "my_cluster" {
+					name           = "my-cluster"
+					cloud_region   = "us-west-1"
+					aws_account_id = "123456789012"
+					aws_billing_account_id = "123456789012"
+					sts = {
+						operator_role_prefix = "test"
+						role_arn = ""
+						support_role_arn = ""
+						instance_iam_roles = {
+							worker_role_arn = ""
+						}
+					}
+					aws_subnet_ids = [
+						"id1", "id2", "id3"
+					]
+					availability_zones = [
+						"us-west-1a",
+						"us-west-1b",
+						"us-west-1c",
+					]
+					version = "4.14.1"
+					upgrade_preflight_checks = true
+					force_upgrade = %t
+				}`, force)
+			}
+			prepareUpgrade := func() {
+				TestServer.AppendHandlers(
+					// Refresh cluster state
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route),
+						RespondWithPatchedJSON(http.StatusOK, template, clusterPatch),
+					),
+					// Check for conflicting upgrade policies while planning
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, emptyControlPlaneUpgradePolicies),
+					),
+					// And again when applying the plan
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, emptyControlPlaneUpgradePolicies),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route),
+						RespondWithPatchedJSON(http.StatusOK, template, clusterPatch),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+						VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+						RespondWithJSON(http.StatusOK, v4141List),
+					),
+					// Look for existing upgrade policies
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, emptyControlPlaneUpgradePolicies),
+					),
+				)
+			}
+
+			It("Blocks the upgrade when the checks fail", func() {
+				workers := []string{}
+				for i := 0; i < 100; i++ {
+					workers = append(workers, fmt.Sprintf(`{
+						"id": "workers-%d",
+						"replicas": 2,
+						"status": {
+							"current_replicas": 2
+						}
+					}`, i))
+				}
+				prepareUpgrade()
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route),
+						RespondWithPatchedJSON(http.StatusOK, template, clusterPatch),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/limited_support_reasons"),
+						RespondWithJSON(http.StatusOK, `{
+							"page": 1,
+							"size": 1,
+							"total": 1,
+							"items": [
+								{
+									"id": "1",
+									"summary": "Cluster is not monitored",
+									"details": "The monitoring stack was removed"
+								}
+							]
+						}`),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/metric_queries/cluster_operators"),
+						RespondWithJSON(http.StatusOK, `{
+							"operators": [
+								{
+									"name": "ingress",
+									"condition": "available"
+								},
+								{
+									"name": "dns",
+									"condition": "degraded",
+									"reason": "DNS pods are crashing"
+								}
+							]
+						}`),
+					),
+					// The scaling machine pool is in the second page:
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/node_pools"),
+						VerifyFormKV("page", "1"),
+						VerifyFormKV("size", "100"),
+						RespondWithJSON(http.StatusOK, fmt.Sprintf(`{
+							"page": 1,
+							"size": 100,
+							"total": 101,
+							"items": [%s]
+						}`, strings.Join(workers, ","))),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/node_pools"),
+						VerifyFormKV("page", "2"),
+						VerifyFormKV("size", "100"),
+						RespondWithJSON(http.StatusOK, `{
+							"page": 2,
+							"size": 1,
+							"total": 101,
+							"items": [
+								{
+									"id": "pool1",
+									"replicas": 3,
+									"status": {
+										"current_replicas": 1,
+										"message": "Scaling up"
+									}
+								}
+							]
+						}`),
+					),
+				)
+				Terraform.Source(clusterSource(false))
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).ToNot(BeZero())
+				runOutput.VerifyErrorContainsSubstring("upgrade preflight checks failed for cluster '123'")
+				runOutput.VerifyErrorContainsSubstring("Cluster is not monitored")
+				runOutput.VerifyErrorContainsSubstring("cluster operator 'dns' is degraded")
+				runOutput.VerifyErrorContainsSubstring("machine pool 'pool1' is scaling")
+			})
+
+			It("Skips the checks when forced", func() {
+				prepareUpgrade()
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, cluster123Route+"/control_plane/upgrade_policies", "dryRun=true"),
+						RespondWithJSON(http.StatusNoContent, ""),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, cluster123Route+"/control_plane/upgrade_policies"),
+						VerifyJQ(".version", "4.14.1"),
+						RespondWithJSON(http.StatusCreated, `
+						{
+							"id": "123",
+							"schedule_type": "manual",
+							"upgrade_type": "ControlPlane",
+							"version": "4.14.1",
+							"next_run": "2023-06-09T20:59:00Z",
+							"cluster_id": "123"
+						}`),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, cluster123Route),
+						RespondWithPatchedJSON(http.StatusCreated, template, clusterPatch),
+					),
+				)
+				Terraform.Source(clusterSource(true))
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).To(BeZero())
+				resource := Terraform.Resource("rhcs_cluster_rosa_hcp", "my_cluster")
+				Expect(resource).To(MatchJQ(".attributes.force_upgrade", true))
+			})
+		})
+
 		It("Cancels and upgrade for the wrong version & schedules new", func() {
 			TestServer.AppendHandlers(
 				// Refresh cluster state
@@ -4840,6 +5404,68 @@ var _ = Describe("HCP Cluster", func() {
 						}
 					]`),
 				),
+				// Check for conflicting upgrade policies while planning
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "UpgradePolicyState",
+						"page": 1,
+						"size": 0,
+						"total": 0,
+						"items": [
+							{
+								"id": "456",
+								"schedule_type": "manual",
+								"upgrade_type": "ControlPlane",
+								"version": "4.14.0",
+								"next_run": "2023-06-09T20:59:00Z",
+								"cluster_id": "123",
+								"enable_minor_version_upgrades": true
+							}
+						]
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, `{
+						"id": "456",
+						"state": {
+							"description": "",
+							"value": "scheduled"
+						}
+					}`),
+				),
+				// And again when applying the plan
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+						"kind": "UpgradePolicyState",
+						"page": 1,
+						"size": 0,
+						"total": 0,
+						"items": [
+							{
+								"id": "456",
+								"schedule_type": "manual",
+								"upgrade_type": "ControlPlane",
+								"version": "4.14.0",
+								"next_run": "2023-06-09T20:59:00Z",
+								"cluster_id": "123",
+								"enable_minor_version_upgrades": true
+							}
+						]
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, `{
+						"id": "456",
+						"state": {
+							"description": "",
+							"value": "scheduled"
+						}
+					}`),
+				),
 				CombineHandlers(
 					VerifyRequest(http.MethodGet, cluster123Route),
 					RespondWithPatchedJSON(http.StatusOK, template, `
@@ -4871,8 +5497,9 @@ var _ = Describe("HCP Cluster", func() {
 					]`),
 				),
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.1"),
-					RespondWithJSON(http.StatusOK, v4141Info),
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+					RespondWithJSON(http.StatusOK, v4141List),
 				),
 				// Look for existing upgrade policies
 				CombineHandlers(
@@ -5169,6 +5796,16 @@ var _ = Describe("HCP Cluster", func() {
 							}
 						]`),
 					),
+					// Check for conflicting upgrade policies while planning
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, emptyControlPlaneUpgradePolicies),
+					),
+					// And again when applying the plan
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/control_plane/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, emptyControlPlaneUpgradePolicies),
+					),
 					CombineHandlers(
 						VerifyRequest(http.MethodGet, cluster123Route),
 						RespondWithPatchedJSON(http.StatusOK, template, `
@@ -5200,8 +5837,9 @@ var _ = Describe("HCP Cluster", func() {
 						]`),
 					),
 					CombineHandlers(
-						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.1"),
-						RespondWithJSON(http.StatusOK, v4141Info),
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+						VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+						RespondWithJSON(http.StatusOK, v4141List),
 					),
 					// Look for existing upgrade policies
 					CombineHandlers(
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcp
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Cluster upgrade policy resource", func() {
+	const hcpCluster = `{
+	  "id": "123",
+	  "name": "my-cluster",
+	  "state": "ready",
+	  "hypershift": {
+	    "enabled": true
+	  },
+	  "version": {
+	    "id": "openshift-v4.15.2",
+	    "raw_id": "4.15.2"
+	  }
+	}`
+	const controlPlanePolicy = `{
+	  "id": "456",
+	  "schedule_type": "automatic",
+	  "schedule": "0 2 * * 6",
+	  "upgrade_type": "ControlPlane",
+	  "next_run": "2024-06-01T02:00:00Z",
+	  "cluster_id": "123",
+	  "state": {
+	    "value": "scheduled",
+	    "description": "Upgrade scheduled."
+	  }
+	}`
+
+	It("Creates an automatic control plane upgrade policy", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123"),
+				RespondWithJSON(http.StatusOK, hcpCluster),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, clusterUri+"123/control_plane/upgrade_policies"),
+				VerifyJQ(".schedule_type", "automatic"),
+				VerifyJQ(".schedule", "0 2 * * 6"),
+				RespondWithJSON(http.StatusCreated, controlPlanePolicy),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123/control_plane/upgrade_policies/456"),
+				RespondWithJSON(http.StatusOK, controlPlanePolicy),
+			),
+		)
+
+		Terraform.Source(`
+		  resource "rhcs_cluster_upgrade_policy" "policy" {
+		    cluster       = "123"
+		    schedule_type = "automatic"
+		    schedule      = "0 2 * * 6"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_cluster_upgrade_policy", "policy")
+		Expect(resource).To(MatchJQ(`.attributes.id`, "456"))
+		Expect(resource).To(MatchJQ(`.attributes.next_run`, "2024-06-01T02:00:00Z"))
+		Expect(resource).To(MatchJQ(`.attributes.state`, "scheduled"))
+		Expect(resource).To(MatchJQ(`.attributes.state_description`, "Upgrade scheduled."))
+	})
+
+	It("Fails to set the node drain grace period", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123"),
+				RespondWithJSON(http.StatusOK, hcpCluster),
+			),
+		)
+
+		Terraform.Source(`
+		  resource "rhcs_cluster_upgrade_policy" "policy" {
+		    cluster                 = "123"
+		    schedule_type           = "automatic"
+		    schedule                = "0 2 * * 6"
+		    node_drain_grace_period = 60
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("only supported for ROSA classic clusters")
+	})
+
+	It("Imports a control plane upgrade policy", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123"),
+				RespondWithJSON(http.StatusOK, hcpCluster),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123/control_plane/upgrade_policies/456"),
+				RespondWithJSON(http.StatusOK, controlPlanePolicy),
+			),
+		)
+
+		Terraform.Source(`
+		  resource "rhcs_cluster_upgrade_policy" "policy" {
+		  }
+		`)
+		runOutput := Terraform.Import("rhcs_cluster_upgrade_policy.policy", "123,456")
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_cluster_upgrade_policy", "policy")
+		Expect(resource).To(MatchJQ(`.attributes.cluster`, "123"))
+		Expect(resource).To(MatchJQ(`.attributes.schedule_type`, "automatic"))
+		Expect(resource).To(MatchJQ(`.attributes.schedule`, "0 2 * * 6"))
+	})
+})
@@ -17,6 +17,7 @@ limitations under the License.
 package hcp
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -181,6 +182,131 @@ var _ = Describe("Hcp Machine pool", func() {
 			}`)
 			Expect(Terraform.Validate()).NotTo(BeZero())
 		})
+
+		It("is invalid to specify a version when following the control plane", func() {
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster = "123"
+				name = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				autoscaling = {
+					enabled = false
+				}
+				auto_repair = true
+				replicas = 3
+				subnet_id = "subnet-123"
+				version = "4.14.0"
+				machine_pool_version_policy = "follow_control_plane"
+			}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("Attribute 'version' can't be set when 'machine_pool_version_policy'")
+		})
+
+		It("is invalid to specify an unsupported version policy", func() {
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster = "123"
+				name = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				replicas = 3
+				subnet_id = "subnet-123"
+				machine_pool_version_policy = "latest"
+			}`)
+			Expect(Terraform.Validate()).NotTo(BeZero())
+		})
+
+		It("is invalid to specify max surge with the in place upgrade type", func() {
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster = "123"
+				name = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				autoscaling = {
+					enabled = false
+				}
+				auto_repair = true
+				replicas = 3
+				subnet_id = "subnet-123"
+				management_upgrade = {
+					type = "InPlace"
+					max_surge = "1"
+				}
+			}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("can only be set with the 'Replace' upgrade type")
+		})
+
+		It("is invalid to specify zero max surge and max unavailable", func() {
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster = "123"
+				name = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				autoscaling = {
+					enabled = false
+				}
+				auto_repair = true
+				replicas = 3
+				subnet_id = "subnet-123"
+				management_upgrade = {
+					max_surge = "0"
+					max_unavailable = "0%"
+				}
+			}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("can't both be zero")
+		})
+
+		It("is invalid to specify a max surge that isn't a number or a percentage", func() {
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster = "123"
+				name = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				autoscaling = {
+					enabled = false
+				}
+				auto_repair = true
+				replicas = 3
+				subnet_id = "subnet-123"
+				management_upgrade = {
+					max_surge = "one"
+				}
+			}`)
+			Expect(Terraform.Validate()).NotTo(BeZero())
+		})
+
+		It("is invalid to specify a node drain grace period longer than a week", func() {
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster = "123"
+				name = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				autoscaling = {
+					enabled = false
+				}
+				auto_repair = true
+				replicas = 3
+				subnet_id = "subnet-123"
+				node_drain_grace_period = 10081
+			}`)
+			Expect(Terraform.Validate()).NotTo(BeZero())
+		})
 	})
 
 	Context("create", func() {
@@ -2226,115 +2352,409 @@ var _ = Describe("Hcp Machine pool", func() {
 			Expect(runOutput.ExitCode).ToNot(BeZero())
 			runOutput.VerifyErrorContainsSubstring("Invalid root disk size")
 		})
-	})
-
-	Context("Standard workers machine pool", func() {
-		BeforeEach(func() {
-			prepareClusterRead("123")
-		})
 
-		It("cannot be created", func() {
-			// Prepare the server:
-			prepareClusterRead("123")
+		It("Can create machine pool with management upgrade settings and update them", func() {
 			TestServer.AppendHandlers(
-				// Get is for the Read function
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, workerNodePoolUri),
-					RespondWithJSON(http.StatusNotFound, `
-						{
-							"kind": "Error",
-							"id": "404",
-							"href": "/api/clusters_mgmt/v1/errors/404",
-							"code": "CLUSTERS-MGMT-404",
-							"reason": "Node pool with id 'worker' not found.",
-							"operation_id": "df359e0c-b1d3-4feb-9b58-50f7a20d0096"
-						}`),
+					VerifyRequest(
+						http.MethodPost,
+						"/api/clusters_mgmt/v1/clusters/123/node_pools",
+					),
+					VerifyJQ(".management_upgrade.type", "Replace"),
+					VerifyJQ(".management_upgrade.max_surge", "2"),
+					VerifyJQ(".management_upgrade.max_unavailable", nil),
+					VerifyJQ(".node_drain_grace_period.value", 30.0),
+					VerifyJQ(".node_drain_grace_period.unit", "minutes"),
+					RespondWithJSON(http.StatusCreated, `{
+					"id":"my-pool",
+					"aws_node_pool":{
+					   "instance_type":"r5.xlarge",
+					   "instance_profile": "bla"
+					},
+					"auto_repair": true,
+					"replicas":2,
+					"subnet":"id-1",
+					"availability_zone":"us-east-1a",
+					"management_upgrade": {
+						"type": "Replace",
+						"max_surge": "2",
+						"max_unavailable": "0"
+					},
+					"node_drain_grace_period": {
+						"value": 30,
+						"unit": "minutes"
+					},
+					"version": {
+						"raw_id": "4.14.10"
+					}
+				}`),
 				),
 			)
+
 			Terraform.Source(`
-				resource "rhcs_hcp_machine_pool" "worker" {
-					cluster      = "123"
-					name         = "worker"
-					aws_node_pool = {
-						instance_type = "r5.xlarge"
-					}
-					autoscaling = {
-						enabled = false
-					}
-					version = "4.14.10"
-					replicas     = 2
-					subnet_id = "subnet-123"
-					auto_repair = true
-				}`)
-			Expect(Terraform.Apply()).NotTo(BeZero())
-		})
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster      = "123"
+				name         = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge",
+				}
+				autoscaling = {
+					enabled = false,
+				}
+				subnet_id = "id-1"
+				replicas     = 2
+				auto_repair = true
+				version = "4.14.10"
+				management_upgrade = {
+					type = "Replace"
+					max_surge = "2"
+				}
+				node_drain_grace_period = 30
+			}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
 
-		It("is automatically imported and updates applied", func() {
-			// Import automatically "Create()", and update the # of replicas: 2 -> 4
-			// Prepare the server:
+			resource := Terraform.Resource("rhcs_hcp_machine_pool", "my_pool")
+			Expect(resource).To(MatchJQ(".attributes.management_upgrade.type", "Replace"))
+			Expect(resource).To(MatchJQ(".attributes.management_upgrade.max_surge", "2"))
+			Expect(resource).To(MatchJQ(".attributes.management_upgrade.max_unavailable", "0"))
+			Expect(resource).To(MatchJQ(".attributes.node_drain_grace_period", 30.0))
+
+			const pool = `{
+				"id": "my-pool",
+				"kind": "MachinePool",
+				"href": "/api/clusters_mgmt/v1/clusters/123/node_pools/my-pool",
+				"replicas": 2,
+				"availability_zone": "us-east-1a",
+				"aws_node_pool": {
+					"instance_type": "r5.xlarge",
+					"instance_profile": "bla"
+				},
+				"auto_repair": true,
+				"management_upgrade": {
+					"type": "Replace",
+					"max_surge": "2",
+					"max_unavailable": "0"
+				},
+				"node_drain_grace_period": {
+					"value": 30,
+					"unit": "minutes"
+				},
+				"version": {
+					"raw_id": "4.14.10"
+				},
+				"subnet": "id-1"
+			}`
 			prepareClusterRead("123")
 			TestServer.AppendHandlers(
-				// Get is for the Read function
+				// First get is for the Read function
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, workerNodePoolUri),
-					RespondWithJSON(http.StatusOK, `
-						{
-							"id": "worker",
-							"replicas": 2,
-							"aws_node_pool":{
-								"instance_type":"r5.xlarge",
-								"instance_profile": "bla"
-							},
-							"version": {
-								"raw_id": "4.14.10"
-							},
-							"subnet": "subnet-123",
-							"auto_repair": true
-						}`),
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/node_pools/my-pool"),
+					RespondWithJSON(http.StatusOK, pool),
 				),
 			)
 			prepareClusterRead("123")
 			TestServer.AppendHandlers(
-				// Get is for the read during update
+				// Second get is for the Update function
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, workerNodePoolUri),
-					RespondWithJSON(http.StatusOK, `
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/node_pools/my-pool"),
+					RespondWithJSON(http.StatusOK, pool),
+				),
+				// The upgrade settings are patched before scheduling any upgrade
+				CombineHandlers(
+					VerifyRequest(
+						http.MethodPatch,
+						"/api/clusters_mgmt/v1/clusters/123/node_pools/my-pool",
+					),
+					VerifyJQ(".management_upgrade.max_surge", "25%"),
+					VerifyJQ(".management_upgrade.max_unavailable", "1"),
+					VerifyJQ(".node_drain_grace_period.value", 60.0),
+					RespondWithPatchedJSON(http.StatusOK, pool, `[
 						{
-							"id": "worker",
-							"replicas": 2,
-							"aws_node_pool":{
-								"instance_type":"r5.xlarge",
-								"instance_profile": "bla"
-							},
-							"version": {
-								"raw_id": "4.14.10"
-							},
-							"subnet": "subnet-123",
-							"auto_repair": true
-						}`),
+							"op": "replace",
+							"path": "/management_upgrade",
+							"value": {
+								"type": "Replace",
+								"max_surge": "25%",
+								"max_unavailable": "1"
+							}
+						},
+						{
+							"op": "replace",
+							"path": "/node_drain_grace_period",
+							"value": {
+								"value": 1,
+								"unit": "hours"
+							}
+						}
+					]`),
 				),
-				// Patch is for the update
+				// Then the rest of the machine pool is patched
 				CombineHandlers(
-					VerifyRequest(http.MethodPatch, workerNodePoolUri),
-					RespondWithJSON(http.StatusOK, `
+					VerifyRequest(
+						http.MethodPatch,
+						"/api/clusters_mgmt/v1/clusters/123/node_pools/my-pool",
+					),
+					VerifyJQ(".management_upgrade", nil),
+					VerifyJQ(".node_drain_grace_period", nil),
+					RespondWithPatchedJSON(http.StatusOK, pool, `[
 						{
-							"id": "worker",
-							"aws_node_pool":{
-								"instance_type":"r5.xlarge"
-							},
-							"version": {
-								"raw_id": "4.14.10"
-							},
-							"subnet": "subnet-123",
-							"replicas": 4,
-							"auto_repair": true
-						}`),
+							"op": "replace",
+							"path": "/management_upgrade",
+							"value": {
+								"type": "Replace",
+								"max_surge": "25%",
+								"max_unavailable": "1"
+							}
+						},
+						{
+							"op": "replace",
+							"path": "/node_drain_grace_period",
+							"value": {
+								"value": 1,
+								"unit": "hours"
+							}
+						}
+					]`),
 				),
 			)
+
 			Terraform.Source(`
-				resource "rhcs_hcp_machine_pool" "worker" {
-					cluster      = "123"
-					name         = "worker"
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster      = "123"
+				name         = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge",
+				}
+				autoscaling = {
+					enabled = false,
+				}
+				subnet_id = "id-1"
+				replicas     = 2
+				auto_repair = true
+				version = "4.14.10"
+				management_upgrade = {
+					type = "Replace"
+					max_surge = "25%"
+					max_unavailable = "1"
+				}
+				node_drain_grace_period = 60
+			}`)
+			runOutput = Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+
+			resource = Terraform.Resource("rhcs_hcp_machine_pool", "my_pool")
+			Expect(resource).To(MatchJQ(".attributes.management_upgrade.max_surge", "25%"))
+			Expect(resource).To(MatchJQ(".attributes.management_upgrade.max_unavailable", "1"))
+			Expect(resource).To(MatchJQ(".attributes.node_drain_grace_period", 60.0))
+		})
+
+		It("Can't change the management upgrade type of a machine pool", func() {
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(
+						http.MethodPost,
+						"/api/clusters_mgmt/v1/clusters/123/node_pools",
+					),
+					RespondWithJSON(http.StatusCreated, `{
+					"id":"my-pool",
+					"aws_node_pool":{
+					   "instance_type":"r5.xlarge",
+					   "instance_profile": "bla"
+					},
+					"auto_repair": true,
+					"replicas":2,
+					"subnet":"id-1",
+					"availability_zone":"us-east-1a",
+					"management_upgrade": {
+						"type": "Replace",
+						"max_surge": "1",
+						"max_unavailable": "0"
+					},
+					"version": {
+						"raw_id": "4.14.10"
+					}
+				}`),
+				),
+			)
+
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster      = "123"
+				name         = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge",
+				}
+				autoscaling = {
+					enabled = false,
+				}
+				subnet_id = "id-1"
+				replicas     = 2
+				auto_repair = true
+				version = "4.14.10"
+				management_upgrade = {}
+			}`)
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_hcp_machine_pool", "my_pool")
+			Expect(resource).To(MatchJQ(".attributes.management_upgrade.type", "Replace"))
+
+			prepareClusterRead("123")
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/clusters/123/node_pools/my-pool"),
+					RespondWithJSON(http.StatusOK, `{
+					"id":"my-pool",
+					"aws_node_pool":{
+					   "instance_type":"r5.xlarge",
+					   "instance_profile": "bla"
+					},
+					"auto_repair": true,
+					"replicas":2,
+					"subnet":"id-1",
+					"availability_zone":"us-east-1a",
+					"management_upgrade": {
+						"type": "Replace",
+						"max_surge": "1",
+						"max_unavailable": "0"
+					},
+					"version": {
+						"raw_id": "4.14.10"
+					}
+				}`),
+				),
+			)
+			Terraform.Source(`
+			resource "rhcs_hcp_machine_pool" "my_pool" {
+				cluster      = "123"
+				name         = "my-pool"
+				aws_node_pool = {
+					instance_type = "r5.xlarge",
+				}
+				autoscaling = {
+					enabled = false,
+				}
+				subnet_id = "id-1"
+				replicas     = 2
+				auto_repair = true
+				version = "4.14.10"
+				management_upgrade = {
+					type = "InPlace"
+				}
+			}`)
+			runOutput = Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring("management_upgrade.type")
+		})
+	})
+
+	Context("Standard workers machine pool", func() {
+		BeforeEach(func() {
+			prepareClusterRead("123")
+		})
+
+		It("cannot be created", func() {
+			// Prepare the server:
+			prepareClusterRead("123")
+			TestServer.AppendHandlers(
+				// Get is for the Read function
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, workerNodePoolUri),
+					RespondWithJSON(http.StatusNotFound, `
+						{
+							"kind": "Error",
+							"id": "404",
+							"href": "/api/clusters_mgmt/v1/errors/404",
+							"code": "CLUSTERS-MGMT-404",
+							"reason": "Node pool with id 'worker' not found.",
+							"operation_id": "df359e0c-b1d3-4feb-9b58-50f7a20d0096"
+						}`),
+				),
+			)
+			Terraform.Source(`
+				resource "rhcs_hcp_machine_pool" "worker" {
+					cluster      = "123"
+					name         = "worker"
+					aws_node_pool = {
+						instance_type = "r5.xlarge"
+					}
+					autoscaling = {
+						enabled = false
+					}
+					version = "4.14.10"
+					replicas     = 2
+					subnet_id = "subnet-123"
+					auto_repair = true
+				}`)
+			Expect(Terraform.Apply()).NotTo(BeZero())
+		})
+
+		It("is automatically imported and updates applied", func() {
+			// Import automatically "Create()", and update the # of replicas: 2 -> 4
+			// Prepare the server:
+			prepareClusterRead("123")
+			TestServer.AppendHandlers(
+				// Get is for the Read function
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, workerNodePoolUri),
+					RespondWithJSON(http.StatusOK, `
+						{
+							"id": "worker",
+							"replicas": 2,
+							"aws_node_pool":{
+								"instance_type":"r5.xlarge",
+								"instance_profile": "bla"
+							},
+							"version": {
+								"raw_id": "4.14.10"
+							},
+							"subnet": "subnet-123",
+							"auto_repair": true
+						}`),
+				),
+			)
+			prepareClusterRead("123")
+			TestServer.AppendHandlers(
+				// Get is for the read during update
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, workerNodePoolUri),
+					RespondWithJSON(http.StatusOK, `
+						{
+							"id": "worker",
+							"replicas": 2,
+							"aws_node_pool":{
+								"instance_type":"r5.xlarge",
+								"instance_profile": "bla"
+							},
+							"version": {
+								"raw_id": "4.14.10"
+							},
+							"subnet": "subnet-123",
+							"auto_repair": true
+						}`),
+				),
+				// Patch is for the update
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, workerNodePoolUri),
+					RespondWithJSON(http.StatusOK, `
+						{
+							"id": "worker",
+							"aws_node_pool":{
+								"instance_type":"r5.xlarge"
+							},
+							"version": {
+								"raw_id": "4.14.10"
+							},
+							"subnet": "subnet-123",
+							"replicas": 4,
+							"auto_repair": true
+						}`),
+				),
+			)
+			Terraform.Source(`
+				resource "rhcs_hcp_machine_pool" "worker" {
+					cluster      = "123"
+					name         = "worker"
 					aws_node_pool = {
 						instance_type = "r5.xlarge"
 						tags = {}
@@ -3574,6 +3994,7 @@ var _ = Describe("Hcp Machine pool", func() {
 		err = cmv1.MarshalVersion(v4141Spec, b)
 		Expect(err).ToNot(HaveOccurred())
 		v4141Info := b.String()
+		v4141List := fmt.Sprintf(`{"page": 1, "size": 1, "total": 1, "items": [%s]}`, v4141Info)
 		prepareClusterRead := func(clusterId string) {
 			TestServer.AppendHandlers(
 				CombineHandlers(
@@ -3593,12 +4014,11 @@ var _ = Describe("Hcp Machine pool", func() {
 						"state": "ready",
 						"version": {
 							"channel_group": "stable",
-							"id": "openshitf-v4.14.0",
-							"raw_id": "4.14.0",
+							"id": "openshift-v4.14.1",
+							"raw_id": "4.14.1",
 							"enabled": true,
 							"rosa_enabled": true,
-							"hosted_control_plane_enabled": true,
-							"available_upgrades": ["4.14.1"]
+							"hosted_control_plane_enabled": true
 						}
 					}`, "ClusterId", clusterId),
 				),
@@ -3693,14 +4113,27 @@ var _ = Describe("Hcp Machine pool", func() {
 		It("Upgrades Machine Pool", func() {
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
+			TestServer.AppendHandlers(
+				// Check for conflicting upgrade policies while planning
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, emptyNodePoolUpgradePolicies),
+				),
+				// And again when applying the plan
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, emptyNodePoolUpgradePolicies),
+				),
+			)
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
 			TestServer.AppendHandlers(
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.1"),
-					RespondWithJSON(http.StatusOK, v4141Info),
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+					RespondWithJSON(http.StatusOK, v4141List),
 				),
 				// Look for existing upgrade policies
 				CombineHandlers(
@@ -3808,35 +4241,491 @@ var _ = Describe("Hcp Machine pool", func() {
 			Expect(runOutput.ExitCode).To(BeZero())
 		})
 
-		It("Does nothing if upgrade is in progress to a different version than the desired", func() {
-			prepareClusterRead(clusterId)
-			preparePoolRead(clusterId, poolId)
-			prepareClusterRead(clusterId)
-			preparePoolRead(clusterId, poolId)
-			prepareClusterRead(clusterId)
-			preparePoolRead(clusterId, poolId)
-			TestServer.AppendHandlers(
-				CombineHandlers(
-					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.1"),
-					RespondWithJSON(http.StatusOK, v4141Info),
-				),
-				// Look for existing upgrade policies
-				CombineHandlers(
-					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
-					RespondWithJSON(http.StatusOK, `{
-						"page": 1,
-						"size": 1,
-						"total": 1,
-						"items": [
-							{
-								"id": "456",
-								"schedule_type": "manual",
-								"upgrade_type": "NodePool",
-								"version": "4.14.0",
-								"next_run": "2023-06-09T20:59:00Z",
-								"cluster_id": "123",
-								"enable_minor_version_upgrades": true
-							}
+		Context("Waiting for the upgrade", func() {
+			const scheduledPolicy = `
+			{
+				"id": "789",
+				"schedule_type": "manual",
+				"upgrade_type": "NodePool",
+				"version": "4.14.1",
+				"next_run": "2023-06-09T20:59:00Z",
+				"cluster_id": "123"
+			}`
+			prepareUpgrade := func() {
+				prepareClusterRead(clusterId)
+				preparePoolRead(clusterId, poolId)
+				for i := 0; i < 2; i++ {
+					TestServer.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+							RespondWithJSON(http.StatusOK, emptyNodePoolUpgradePolicies),
+						),
+					)
+				}
+				prepareClusterRead(clusterId)
+				preparePoolRead(clusterId, poolId)
+				prepareClusterRead(clusterId)
+				preparePoolRead(clusterId, poolId)
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+						VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+						RespondWithJSON(http.StatusOK, v4141List),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, emptyNodePoolUpgradePolicies),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, cluster123Route+"/node_pools/pool1/upgrade_policies", "dryRun=true"),
+						RespondWithJSON(http.StatusNoContent, ""),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+						VerifyJQ(".version", "4.14.1"),
+						RespondWithJSON(http.StatusCreated, scheduledPolicy),
+					),
+				)
+			}
+			poolTemplate := `
+			resource "rhcs_hcp_machine_pool" "{{.PoolId}}" {
+				cluster      = "{{.ClusterId}}"
+				name         = "{{.PoolId}}"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				replicas     = 3
+				subnet_id = "subnet-123"
+				autoscaling = {
+					enabled = false
+				}
+				version = "4.14.1"
+				auto_repair = true
+				wait_for_upgrade_complete = true
+			}`
+
+			It("Waits until the upgrade is completed", func() {
+				prepareUpgrade()
+				TestServer.AppendHandlers(
+					// Wait for the scheduled upgrade
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies/789"),
+						RespondWithJSON(http.StatusOK, `{
+							"id": "789",
+							"state": {
+								"description": "Upgrade completed",
+								"value": "completed"
+							}
+						}`),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, cluster123Route+"/node_pools/pool1"),
+						RespondWithJSON(http.StatusOK, `
+						{
+							"id": "pool1",
+							"replicas": 3,
+							"subnet": "subnet-123",
+							"auto_repair": true
+						}`),
+					),
+				)
+				Terraform.Source(EvaluateTemplate(poolTemplate, "PoolId", poolId, "ClusterId", clusterId))
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).To(BeZero())
+				resource := Terraform.Resource("rhcs_hcp_machine_pool", poolId)
+				Expect(resource).To(MatchJQ(".attributes.wait_for_upgrade_complete", true))
+			})
+
+			It("Fails with the policy description if the upgrade fails", func() {
+				prepareUpgrade()
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies/789"),
+						RespondWithJSON(http.StatusOK, `{
+							"id": "789",
+							"state": {
+								"description": "Nodes failed to drain",
+								"value": "failed"
+							}
+						}`),
+					),
+				)
+				Terraform.Source(EvaluateTemplate(poolTemplate, "PoolId", poolId, "ClusterId", clusterId))
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).ToNot(BeZero())
+				runOutput.VerifyErrorContainsSubstring("upgrade policy '789' failed: Nodes failed to drain")
+			})
+		})
+
+		It("Rejects a version newer than the control plane while planning", func() {
+			prepareClusterRead(clusterId)
+			preparePoolRead(clusterId, poolId)
+			Terraform.Source(EvaluateTemplate(`
+			resource "rhcs_hcp_machine_pool" "{{.PoolId}}" {
+				cluster      = "{{.ClusterId}}"
+				name         = "{{.PoolId}}"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				replicas     = 3
+				subnet_id = "subnet-123"
+				autoscaling = {
+					enabled = false
+				}
+				version = "4.15.0"
+				auto_repair = true
+			}`, "PoolId", poolId, "ClusterId", clusterId))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).ToNot(BeZero())
+			runOutput.VerifyErrorContainsSubstring(
+				"machine pool version '4.15.0' can't be newer than the control plane version '4.14.1'")
+		})
+
+		It("Upgrades a machine pool that follows the control plane", func() {
+			prepareClusterRead(clusterId)
+			preparePoolRead(clusterId, poolId)
+			prepareClusterRead(clusterId)
+			preparePoolRead(clusterId, poolId)
+			prepareClusterRead(clusterId)
+			preparePoolRead(clusterId, poolId)
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+					RespondWithJSON(http.StatusOK, v4141List),
+				),
+				// Look for existing upgrade policies
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, emptyNodePoolUpgradePolicies),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, cluster123Route+"/node_pools/pool1/upgrade_policies", "dryRun=true"),
+					RespondWithJSON(http.StatusNoContent, ""),
+				),
+				// Create an upgrade policy to the control plane version
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+					VerifyJQ(".version", "4.14.1"),
+					RespondWithJSON(http.StatusCreated, `
+					{
+						"id": "789",
+						"schedule_type": "manual",
+						"upgrade_type": "NodePool",
+						"version": "4.14.1",
+						"next_run": "2023-06-09T20:59:00Z",
+						"cluster_id": "123"
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, cluster123Route+"/node_pools/pool1"),
+					RespondWithJSON(http.StatusOK, `
+					{
+						"id": "pool1",
+						"replicas": 3,
+						"subnet": "subnet-123",
+						"auto_repair": true,
+						"version": {
+							"id": "openshift-v4.14.0",
+							"raw_id": "4.14.0"
+						}
+					}`),
+				),
+			)
+			Terraform.Source(EvaluateTemplate(`
+			resource "rhcs_hcp_machine_pool" "{{.PoolId}}" {
+				cluster      = "{{.ClusterId}}"
+				name         = "{{.PoolId}}"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				replicas     = 3
+				subnet_id = "subnet-123"
+				autoscaling = {
+					enabled = false
+				}
+				auto_repair = true
+				machine_pool_version_policy = "follow_control_plane"
+			}`, "PoolId", poolId, "ClusterId", clusterId))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_hcp_machine_pool", poolId)
+			Expect(resource).To(MatchJQ(".attributes.machine_pool_version_policy", "follow_control_plane"))
+			Expect(resource).To(MatchJQ(".attributes.control_plane_version", "4.14.1"))
+			Expect(resource).To(MatchJQ(".attributes.current_version", "4.14.0"))
+		})
+
+		It("Doesn't schedule an upgrade of a machine pool that follows the control plane if it is in progress", func() {
+			const inProgressPolicies = `{
+				"page": 1,
+				"size": 1,
+				"total": 1,
+				"items": [
+					{
+						"id": "789",
+						"node_pool_id": "pool1",
+						"cluster_id": "123",
+						"schedule_type": "manual",
+						"upgrade_type": "NodePool",
+						"version": "4.14.1",
+						"next_run": "2023-06-09T20:59:00Z"
+					}
+				]
+			}`
+			const inProgressPolicy = `{
+				"id": "789",
+				"cluster_id": "123",
+				"state": {
+					"description": "Upgrade in progress",
+					"value": "started"
+				}
+			}`
+			prepareUpgradePolicies := func() {
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, inProgressPolicies),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies/789"),
+						RespondWithJSON(http.StatusOK, inProgressPolicy),
+					),
+				)
+			}
+
+			// Switch the machine pool to follow the control plane, while its upgrade is in
+			// progress, so no new upgrade is scheduled:
+			prepareClusterRead(clusterId)
+			preparePoolRead(clusterId, poolId)
+			prepareClusterRead(clusterId)
+			preparePoolRead(clusterId, poolId)
+			prepareClusterRead(clusterId)
+			preparePoolRead(clusterId, poolId)
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+					RespondWithJSON(http.StatusOK, v4141List),
+				),
+			)
+			prepareUpgradePolicies()
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, cluster123Route+"/node_pools/pool1"),
+					RespondWithJSON(http.StatusOK, `
+					{
+						"id": "pool1",
+						"replicas": 3,
+						"subnet": "subnet-123",
+						"auto_repair": true,
+						"version": {
+							"id": "openshift-v4.14.0",
+							"raw_id": "4.14.0"
+						}
+					}`),
+				),
+			)
+			Terraform.Source(EvaluateTemplate(`
+			resource "rhcs_hcp_machine_pool" "{{.PoolId}}" {
+				cluster      = "{{.ClusterId}}"
+				name         = "{{.PoolId}}"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				replicas     = 3
+				subnet_id = "subnet-123"
+				autoscaling = {
+					enabled = false
+				}
+				auto_repair = true
+				machine_pool_version_policy = "follow_control_plane"
+			}`, "PoolId", poolId, "ClusterId", clusterId))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_hcp_machine_pool", poolId)
+			Expect(resource).To(MatchJQ(".attributes.current_version", "4.14.0"))
+		})
+
+		It("Doesn't plan an upgrade of a machine pool that follows the control plane in a non-stable channel group", func() {
+			prepareCandidateReads := func() {
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route),
+						RespondWithJSON(http.StatusOK, `{
+							"id": "123",
+							"name": "my-cluster",
+							"state": "ready",
+							"version": {
+								"channel_group": "candidate",
+								"id": "openshift-v4.14.1-candidate",
+								"raw_id": "4.14.1"
+							}
+						}`),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1"),
+						RespondWithJSON(http.StatusOK, `{
+							"id": "pool1",
+							"kind": "NodePool",
+							"replicas": 3,
+							"aws_node_pool": {
+								"instance_type": "r5.xlarge",
+								"instance_profile": "bla"
+							},
+							"version": {
+								"channel_group": "candidate",
+								"id": "openshift-v4.14.1-candidate",
+								"raw_id": "4.14.1"
+							},
+							"auto_repair": true,
+							"subnet": "subnet-123"
+						}`),
+					),
+				)
+			}
+			prepareCandidateReads()
+			prepareCandidateReads()
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, emptyNodePoolUpgradePolicies),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, cluster123Route+"/node_pools/pool1"),
+					RespondWithJSON(http.StatusOK, `{
+						"id": "pool1",
+						"replicas": 3,
+						"subnet": "subnet-123",
+						"auto_repair": true,
+						"version": {
+							"channel_group": "candidate",
+							"id": "openshift-v4.14.1-candidate",
+							"raw_id": "4.14.1"
+						}
+					}`),
+				),
+			)
+			Terraform.Source(EvaluateTemplate(`
+			resource "rhcs_hcp_machine_pool" "{{.PoolId}}" {
+				cluster      = "{{.ClusterId}}"
+				name         = "{{.PoolId}}"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				replicas     = 3
+				subnet_id = "subnet-123"
+				autoscaling = {
+					enabled = false
+				}
+				auto_repair = true
+				machine_pool_version_policy = "follow_control_plane"
+			}`, "PoolId", poolId, "ClusterId", clusterId))
+			runOutput := Terraform.Apply()
+			Expect(runOutput.ExitCode).To(BeZero())
+			resource := Terraform.Resource("rhcs_hcp_machine_pool", poolId)
+			Expect(resource).To(MatchJQ(".attributes.current_version", "4.14.1"))
+			Expect(resource).To(MatchJQ(".attributes.control_plane_version", "4.14.1"))
+
+			// The versions match, so the next plan doesn't request an upgrade:
+			prepareCandidateReads()
+			runOutput = Terraform.Run("plan", "-detailed-exitcode")
+			Expect(runOutput.ExitCode).To(BeZero())
+		})
+
+		It("Does nothing if upgrade is in progress to a different version than the desired", func() {
+			prepareClusterRead(clusterId)
+			preparePoolRead(clusterId, poolId)
+			TestServer.AppendHandlers(
+				// Check for conflicting upgrade policies while planning
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"id": "456",
+								"schedule_type": "manual",
+								"upgrade_type": "NodePool",
+								"version": "4.14.0",
+								"next_run": "2023-06-09T20:59:00Z",
+								"cluster_id": "123",
+								"enable_minor_version_upgrades": true
+							}
+						]
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, `
+					{
+						"id": "456",
+						"state": {
+							"description": "Upgrade in progress",
+							"value": "started"
+						}
+					}`),
+				),
+				// And again when applying the plan
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"id": "456",
+								"schedule_type": "manual",
+								"upgrade_type": "NodePool",
+								"version": "4.14.0",
+								"next_run": "2023-06-09T20:59:00Z",
+								"cluster_id": "123",
+								"enable_minor_version_upgrades": true
+							}
+						]
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, `
+					{
+						"id": "456",
+						"state": {
+							"description": "Upgrade in progress",
+							"value": "started"
+						}
+					}`),
+				),
+			)
+			prepareClusterRead(clusterId)
+			preparePoolRead(clusterId, poolId)
+			prepareClusterRead(clusterId)
+			preparePoolRead(clusterId, poolId)
+			TestServer.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+					RespondWithJSON(http.StatusOK, v4141List),
+				),
+				// Look for existing upgrade policies
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"id": "456",
+								"schedule_type": "manual",
+								"upgrade_type": "NodePool",
+								"version": "4.14.0",
+								"next_run": "2023-06-09T20:59:00Z",
+								"cluster_id": "123",
+								"enable_minor_version_upgrades": true
+							}
 						]
 					}`),
 				),
@@ -3877,14 +4766,85 @@ var _ = Describe("Hcp Machine pool", func() {
 		It("Cancels and upgrade for the wrong version & schedules new", func() {
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
+			TestServer.AppendHandlers(
+				// Check for conflicting upgrade policies while planning
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"id": "456",
+								"node_pool_id": "pool1",
+								"cluster_id": "123",
+								"schedule_type": "manual",
+								"upgrade_type": "NodePool",
+								"version": "4.14.0",
+								"next_run": "2023-06-09T20:59:00Z",
+								"cluster_id": "123",
+								"enable_minor_version_upgrades": true
+							}
+						]
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, `
+					{
+						"id": "456",
+						"cluster_id": "123",
+						"state": {
+							"description": "Upgrade in progress",
+							"value": "scheduled"
+						}
+					}`),
+				),
+				// And again when applying the plan
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+					RespondWithJSON(http.StatusOK, `{
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+							{
+								"id": "456",
+								"node_pool_id": "pool1",
+								"cluster_id": "123",
+								"schedule_type": "manual",
+								"upgrade_type": "NodePool",
+								"version": "4.14.0",
+								"next_run": "2023-06-09T20:59:00Z",
+								"cluster_id": "123",
+								"enable_minor_version_upgrades": true
+							}
+						]
+					}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies/456"),
+					RespondWithJSON(http.StatusOK, `
+					{
+						"id": "456",
+						"cluster_id": "123",
+						"state": {
+							"description": "Upgrade in progress",
+							"value": "scheduled"
+						}
+					}`),
+				),
+			)
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
 			TestServer.AppendHandlers(
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.1"),
-					RespondWithJSON(http.StatusOK, v4141Info),
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+					RespondWithJSON(http.StatusOK, v4141List),
 				),
 				// Look for existing upgrade policies
 				CombineHandlers(
@@ -3980,6 +4940,110 @@ var _ = Describe("Hcp Machine pool", func() {
 			Expect(runOutput.ExitCode).To(BeZero())
 		})
 
+		Context("Conflicting upgrades", func() {
+			const conflictingPolicies = `{
+				"page": 1,
+				"size": 1,
+				"total": 1,
+				"items": [
+					{
+						"id": "456",
+						"node_pool_id": "pool1",
+						"cluster_id": "123",
+						"schedule_type": "manual",
+						"upgrade_type": "NodePool",
+						"version": "4.14.0",
+						"next_run": "2023-06-09T20:59:00Z"
+					}
+				]
+			}`
+			const conflictingPolicy = `{
+				"id": "456",
+				"cluster_id": "123",
+				"state": {
+					"description": "Upgrade scheduled by an SRE",
+					"value": "scheduled"
+				}
+			}`
+			prepareUpgrade := func() {
+				prepareClusterRead(clusterId)
+				preparePoolRead(clusterId, poolId)
+				for i := 0; i < 2; i++ {
+					TestServer.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+							RespondWithJSON(http.StatusOK, conflictingPolicies),
+						),
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies/456"),
+							RespondWithJSON(http.StatusOK, conflictingPolicy),
+						),
+					)
+				}
+				prepareClusterRead(clusterId)
+				preparePoolRead(clusterId, poolId)
+				prepareClusterRead(clusterId)
+				preparePoolRead(clusterId, poolId)
+				TestServer.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+						VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+						RespondWithJSON(http.StatusOK, v4141List),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, conflictingPolicies),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies/456"),
+						RespondWithJSON(http.StatusOK, conflictingPolicy),
+					),
+				)
+			}
+
+			source := func(conflictPolicy string) string {
+				return EvaluateTemplate(`
+			resource "rhcs_hcp_machine_pool" "{{.PoolId}}" {
+				cluster      = "{{.ClusterId}}"
+				name         = "{{.PoolId}}"
+				aws_node_pool = {
+					instance_type = "r5.xlarge"
+				}
+				replicas     = 3
+				subnet_id = "subnet-123"
+				autoscaling = {
+					enabled = false
+				}
+				version = "4.14.1"
+				auto_repair = true
+				upgrade_conflict_policy = "{{.ConflictPolicy}}"
+			}`, "PoolId", poolId, "ClusterId", clusterId, "ConflictPolicy", conflictPolicy)
+			}
+
+			It("Fails the upgrade if the policy is fail", func() {
+				prepareUpgrade()
+				Terraform.Source(source("fail"))
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).ToNot(BeZero())
+				runOutput.VerifyOutputContainsSubstring("Conflicting upgrades")
+				runOutput.VerifyErrorContainsSubstring("upgrade policy '456' to version '4.14.0'")
+			})
+
+			It("Keeps the conflicting upgrades and doesn't record the requested version", func() {
+				prepareUpgrade()
+				Terraform.Source(source("keep"))
+				runOutput := Terraform.Apply()
+				Expect(runOutput.ExitCode).ToNot(BeZero())
+				runOutput.VerifyOutputContainsSubstring("Conflicting upgrades")
+				runOutput.VerifyErrorContainsSubstring("upgrade to version '4.14.1' can't be scheduled")
+				runOutput.VerifyErrorContainsSubstring("upgrade policy '456' to version '4.14.0'")
+
+				// The version bump stays pending, so it is requested again by the next apply:
+				resource := Terraform.Resource("rhcs_hcp_machine_pool", poolId)
+				Expect(resource).To(MatchJQ(".attributes.version", "4.14.0"))
+			})
+		})
+
 		It("Cancels upgrade if version=current_version", func() {
 			prepareClusterRead(clusterId)
 			preparePoolRead(clusterId, poolId)
@@ -3989,8 +5053,9 @@ var _ = Describe("Hcp Machine pool", func() {
 			preparePoolRead(clusterId, poolId)
 			TestServer.AppendHandlers(
 				CombineHandlers(
-					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.1"),
-					RespondWithJSON(http.StatusOK, v4141Info),
+					VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+					VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+					RespondWithJSON(http.StatusOK, v4141List),
 				),
 				// Look for existing upgrade policies
 				CombineHandlers(
@@ -4219,14 +5284,27 @@ var _ = Describe("Hcp Machine pool", func() {
 			BeforeEach(func() {
 				prepareClusterRead(clusterId)
 				preparePoolRead(clusterId, poolId)
+				TestServer.AppendHandlers(
+					// Check for conflicting upgrade policies while planning
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, emptyNodePoolUpgradePolicies),
+					),
+					// And again when applying the plan
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, cluster123Route+"/node_pools/pool1/upgrade_policies"),
+						RespondWithJSON(http.StatusOK, emptyNodePoolUpgradePolicies),
+					),
+				)
 				prepareClusterRead(clusterId)
 				preparePoolRead(clusterId, poolId)
 				prepareClusterRead(clusterId)
 				preparePoolRead(clusterId, poolId)
 				TestServer.AppendHandlers(
 					CombineHandlers(
-						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions/openshift-v4.14.1"),
-						RespondWithJSON(http.StatusOK, v4141Info),
+						VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/versions"),
+						VerifyFormKV("search", "id in ('openshift-v4.14.1')"),
+						RespondWithJSON(http.StatusOK, v4141List),
 					),
 					// Look for existing upgrade policies
 					CombineHandlers(
@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcp
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("Hcp machine pools data source", func() {
+	const nodePools = `{
+	  "page": 1,
+	  "size": 3,
+	  "total": 3,
+	  "items": [
+	    {
+	      "id": "workers-0",
+	      "replicas": 2,
+	      "auto_repair": true,
+	      "availability_zone": "us-east-1a",
+	      "subnet": "subnet-a",
+	      "aws_node_pool": {
+	        "instance_type": "m5.xlarge"
+	      },
+	      "version": {
+	        "id": "openshift-v4.14.8",
+	        "raw_id": "4.14.8"
+	      }
+	    },
+	    {
+	      "id": "workers-1",
+	      "autoscaling": {
+	        "min_replica": 1,
+	        "max_replica": 4
+	      },
+	      "auto_repair": true,
+	      "availability_zone": "us-east-1b",
+	      "subnet": "subnet-b",
+	      "labels": {
+	        "role": "batch"
+	      },
+	      "aws_node_pool": {
+	        "instance_type": "m5.2xlarge"
+	      },
+	      "version": {
+	        "id": "openshift-v4.15.2",
+	        "raw_id": "4.15.2"
+	      }
+	    },
+	    {
+	      "id": "workers-2",
+	      "replicas": 1,
+	      "auto_repair": false,
+	      "availability_zone": "us-east-1b",
+	      "subnet": "subnet-b",
+	      "labels": {
+	        "role": "batch"
+	      },
+	      "aws_node_pool": {
+	        "instance_type": "m5.xlarge"
+	      },
+	      "version": {
+	        "id": "openshift-v4.14.10",
+	        "raw_id": "4.14.10"
+	      }
+	    }
+	  ]
+	}`
+
+	prepareServer := func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123"),
+				RespondWithJSON(http.StatusOK, `{
+				  "id": "123",
+				  "name": "my-cluster",
+				  "state": "ready",
+				  "version": {
+				    "channel_group": "stable"
+				  }
+				}`),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, clusterUri+"123/node_pools"),
+				RespondWithJSON(http.StatusOK, nodePools),
+			),
+		)
+	}
+
+	It("Can list all machine pools", func() {
+		prepareServer()
+
+		Terraform.Source(`
+		  data "rhcs_hcp_machine_pools" "my_pools" {
+		    cluster = "123"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_hcp_machine_pools", "my_pools")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 3))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "workers-0"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].replicas`, 2.0))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].aws_node_pool.instance_type`, "m5.xlarge"))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].current_version`, "4.14.8"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].autoscaling.enabled`, true))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].autoscaling.max_replicas`, 4.0))
+		Expect(resource).To(MatchJQ(`.attributes.items[2].auto_repair`, false))
+	})
+
+	It("Can filter machine pools by version constraint", func() {
+		prepareServer()
+
+		Terraform.Source(`
+		  data "rhcs_hcp_machine_pools" "my_pools" {
+		    cluster = "123"
+		    version = "< 4.15.0"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_hcp_machine_pools", "my_pools")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 2))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "workers-0"))
+		Expect(resource).To(MatchJQ(`.attributes.items[1].id`, "workers-2"))
+	})
+
+	It("Can filter machine pools by labels, instance type, availability zone and autoscaling", func() {
+		prepareServer()
+
+		Terraform.Source(`
+		  data "rhcs_hcp_machine_pools" "my_pools" {
+		    cluster             = "123"
+		    instance_type       = "m5.xlarge"
+		    availability_zone   = "us-east-1b"
+		    autoscaling_enabled = false
+		    labels = {
+		      "role" = "batch"
+		    }
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_hcp_machine_pools", "my_pools")
+		Expect(resource).To(MatchJQ(`.attributes.items | length`, 1))
+		Expect(resource).To(MatchJQ(`.attributes.items[0].id`, "workers-2"))
+	})
+
+	It("Fails on an invalid version constraint", func() {
+		Terraform.Source(`
+		  data "rhcs_hcp_machine_pools" "my_pools" {
+		    cluster = "123"
+		    version = "not a version"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).ToNot(BeZero())
+		runOutput.VerifyErrorContainsSubstring("Invalid version constraint")
+	})
+})
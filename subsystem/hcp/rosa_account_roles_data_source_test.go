@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcp
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"             // nolint
+	. "github.com/onsi/gomega"                         // nolint
+	. "github.com/onsi/gomega/ghttp"                   // nolint
+	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+	. "github.com/terraform-redhat/terraform-provider-rhcs/subsystem/framework"
+)
+
+var _ = Describe("ROSA HCP account roles data source", func() {
+	It("Lists the account roles with AWS managed policies", func() {
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/aws_inquiries/sts_policies"),
+				RespondWithJSON(http.StatusOK, `{
+				  "kind": "STSPoliciesList",
+				  "page": 1,
+				  "size": 4,
+				  "total": 4,
+				  "items": [
+				    {
+				      "kind": "STSPolicy",
+				      "id": "sts_hcp_installer_permission_policy",
+				      "arn": "arn:aws:iam::aws:policy/service-role/ROSAInstallerPolicy",
+				      "type": "AccountRole"
+				    },
+				    {
+				      "kind": "STSPolicy",
+				      "id": "sts_hcp_support_permission_policy",
+				      "arn": "arn:aws:iam::aws:policy/service-role/ROSASRESupportPolicy",
+				      "type": "AccountRole"
+				    },
+				    {
+				      "kind": "STSPolicy",
+				      "id": "sts_hcp_instance_worker_permission_policy",
+				      "arn": "arn:aws:iam::aws:policy/service-role/ROSAWorkerInstancePolicy",
+				      "type": "AccountRole"
+				    },
+				    {
+				      "kind": "STSPolicy",
+				      "id": "sts_support_trust_policy",
+				      "details": "{\"Version\": \"2012-10-17\", \"Statement\": [{\"Action\": [\"sts:AssumeRole\"], \"Effect\": \"Allow\", \"Principal\": {\"AWS\": [\"arn:aws:iam::12345678912:role/RH-Technical-Support-12345678\"]}}]}",
+				      "type": "AccountRole"
+				    }
+				  ]
+				}`),
+			),
+		)
+		Terraform.Source(`
+		  data "rhcs_rosa_hcp_account_roles" "account_roles" {
+		    account_role_prefix = "my-prefix"
+		    account_id          = "123456789012"
+		    aws_partition       = "aws-us-gov"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		resource := Terraform.Resource("rhcs_rosa_hcp_account_roles", "account_roles")
+		Expect(resource).To(MatchJQ(`.attributes.account_roles | length`, 3))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].role_name`, "my-prefix-HCP-ROSA-Installer-Role"))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].role_arn`, "arn:aws-us-gov:iam::123456789012:role/my-prefix-HCP-ROSA-Installer-Role"))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].managed_policy`, true))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].policy_name`, "ROSAInstallerPolicy"))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].policy_arn`, "arn:aws-us-gov:iam::aws:policy/service-role/ROSAInstallerPolicy"))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[0].policy_document`, nil))
+		Expect(resource).To(MatchJQ(`.attributes.account_roles[2].tags.rosa_hcp_policies`, "true"))
+		Expect(resource).To(MatchJQ(`.attributes.rh_support_role_arn`, "arn:aws:iam::12345678912:role/RH-Technical-Support-12345678"))
+	})
+})
@@ -222,6 +222,7 @@ var _ = Describe("Hcp OCM policies data source", func() {
 		resource := Terraform.Resource("rhcs_hcp_policies", "my_policies").(map[string]interface{})
 		Expect(fmt.Sprint(resource["attributes"])).To(Equal(fmt.Sprint(
 			map[string]interface{}{
+				"aws_partition": nil,
 				"operator_role_policies": map[string]interface{}{
 					"openshift_hcp_image_registry_installer_cloud_credentials_policy":        "arn:aws:iam::aws:policy/service-role/ROSAImageRegistryOperatorPolicy",
 					"openshift_hcp_ingress_operator_cloud_credentials_policy":                "arn:aws:iam::aws:policy/service-role/ROSAIngressOperatorPolicy",
@@ -241,4 +242,30 @@ var _ = Describe("Hcp OCM policies data source", func() {
 			},
 		)))
 	})
+
+	It("Can list OCM policies for Hcp in another AWS partition", func() {
+		// Prepare the server:
+		TestServer.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/api/clusters_mgmt/v1/aws_inquiries/sts_policies"),
+				RespondWithJSON(http.StatusOK, getStsPoliciesRequests),
+			),
+		)
+
+		// Run the apply command:
+		Terraform.Source(`
+		  data "rhcs_hcp_policies" "my_policies" {
+		    aws_partition = "aws-cn"
+		  }
+		`)
+		runOutput := Terraform.Apply()
+		Expect(runOutput.ExitCode).To(BeZero())
+
+		// Check the state:
+		resource := Terraform.Resource("rhcs_hcp_policies", "my_policies")
+		Expect(resource).To(MatchJQ(`.attributes.account_role_policies.sts_hcp_installer_permission_policy`,
+			"arn:aws-cn:iam::aws:policy/service-role/ROSAInstallerPolicy"))
+		Expect(resource).To(MatchJQ(`.attributes.operator_role_policies.openshift_hcp_kms_provider_credentials_policy`,
+			"arn:aws-cn:iam::aws:policy/service-role/ROSAKMSProviderPolicy"))
+	})
 })